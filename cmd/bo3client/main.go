@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"math"
 	"runtime"
 
@@ -22,6 +21,11 @@ func init() {
 }
 
 func main() {
+	// Window flags (MSAA) must be set before the window is created
+	opts := renderer.DefaultOptions()
+	opts.MSAASamples = 4
+	opts.ApplyConfigFlags()
+
 	// Init raylib
 	rl.InitWindow(width, height, "BO3 Go (Go)")
 	defer rl.CloseWindow()
@@ -30,11 +34,14 @@ func main() {
 	rl.SetTargetFPS(60)
 
 	// Create renderer
-	rend := renderer.NewRenderer(width, height)
+	rend := renderer.NewRendererWithOptions(width, height, opts)
 
 	// Create camera
 	cam := camera.NewCamera(mgl32.Vec3{0, 0, 3}, mgl32.Vec3{0, 1, 0}, -90.0, 0.0)
 
+	// Show FPS, draw calls and light counts in the corner
+	rend.DrawDebugOverlay(true)
+
 	// Set global ambient light
 	rend.AddGlobalLight(mgl32.Vec3{0.3, 0.3, 0.4}, 1.0)
 
@@ -52,12 +59,27 @@ func main() {
 		}
 		lastTime = currentTime
 
-		// Keyboard input (WASD)
+		// Keyboard input (WASD, space/ctrl for up/down, shift to sprint)
 		forward := rl.IsKeyDown(rl.KeyW)
 		backward := rl.IsKeyDown(rl.KeyS)
 		left := rl.IsKeyDown(rl.KeyA)
 		right := rl.IsKeyDown(rl.KeyD)
-		cam.ProcessKeyboard(forward, backward, left, right, dt)
+		up := rl.IsKeyDown(rl.KeySpace)
+		down := rl.IsKeyDown(rl.KeyLeftControl)
+		sprint := rl.IsKeyDown(rl.KeyLeftShift)
+		cam.ProcessKeyboardEx(forward, backward, left, right, up, down, sprint, dt)
+
+		// Gamepad input (left stick moves, right stick looks)
+		if rl.IsGamepadAvailable(0) {
+			cam.ProcessGamepad(
+				rl.GetGamepadAxisMovement(0, rl.GamepadAxisLeftX),
+				rl.GetGamepadAxisMovement(0, rl.GamepadAxisLeftY),
+				rl.GetGamepadAxisMovement(0, rl.GamepadAxisRightX),
+				rl.GetGamepadAxisMovement(0, rl.GamepadAxisRightY),
+				dt,
+			)
+		}
+		cam.Update(dt)
 
 		delta := rl.GetMouseDelta()
 		cam.ProcessMouse(delta.X, delta.Y)
@@ -120,16 +142,6 @@ func main() {
 			mgl32.Vec4{1, 0, 0, 1}, // color (red)
 			"LightCube",
 		)
-		rend.PushUIText(
-			mgl32.Vec3{0, 10, -5},
-			mgl32.Vec4{1, 0, 0, 1},
-			fmt.Sprintf("Prims: %d", rend.GetPrimCount()),
-		)
-		rend.PushUIText(
-			mgl32.Vec3{0, 30, -5},
-			mgl32.Vec4{1, 0, 0, 1},
-			fmt.Sprintf("Light sources: %d", rend.GetLCount()),
-		)
 		rend.EndFrame(rlCam)
 
 	}
@@ -2,11 +2,31 @@ package camera
 
 import (
 	"math"
+	"time"
 
 	"github.com/go-gl/mathgl/mgl32"
 )
 
-// Camera is a simple freecam camera.
+// Mode selects how the camera reacts to input.
+type Mode int
+
+const (
+	// ModeFree is the default freecam driven by ProcessKeyboard/ProcessMouse.
+	ModeFree Mode = iota
+	// ModeOrbit rotates around Target at Distance, always looking inward.
+	ModeOrbit
+)
+
+// gamepadLookRate converts right stick tilt to mouse pixels per second.
+const gamepadLookRate = 1000.0
+
+// FOV limits enforced by SetTargetFOV (degrees).
+const (
+	MinFOV = 1.0
+	MaxFOV = 120.0
+)
+
+// Camera is a simple freecam camera that can also orbit a target point.
 type Camera struct {
 	Position mgl32.Vec3
 	Front    mgl32.Vec3
@@ -16,32 +36,76 @@ type Camera struct {
 
 	Yaw   float32
 	Pitch float32
+	Roll  float32 // degrees, banks Up/Right around Front
 
 	Speed       float32
 	Sensitivity float32
+	// SprintFactor multiplies Speed while sprinting
+	SprintFactor float32
+	// GamepadDeadzone is the stick magnitude below which input is ignored
+	GamepadDeadzone float32
+
+	// orbit params, used when Mode is ModeOrbit (switch with SetMode)
+	Mode        Mode
+	Target      mgl32.Vec3
+	Distance    float32
+	MinDistance float32
+	MaxDistance float32
 
 	// projection params
 	FOV    float32
 	Aspect float32
 	Near   float32
 	Far    float32
+	// FOVSpeed is the rate (1/s) Update eases FOV toward SetTargetFOV's value;
+	// <= 0 makes the zoom instant
+	FOVSpeed float32
+
+	// focus transition state, driven by Update. In orbit mode From/To are
+	// the orbit Target and the distance is animated too.
+	focusFrom     mgl32.Vec3
+	focusTo       mgl32.Vec3
+	focusDistFrom float32
+	focusDistTo   float32
+	focusElapsed  float32
+	focusDuration float32
+	focusing      bool
+
+	// smoothing state: input moves the targets, Update eases toward them
+	smoothing   float32
+	targetPos   mgl32.Vec3
+	targetYaw   float32
+	targetPitch float32
+	targetFOV   float32
+	zoomingFOV  bool
+
+	// optional movement box, see SetBounds
+	hasBounds bool
+	boundsMin mgl32.Vec3
+	boundsMax mgl32.Vec3
 }
 
 // NewCamera creates a camera positioned at pos, looking with yaw/pitch (degrees).
 // up is usually mgl32.Vec3{0,1,0}.
 func NewCamera(pos, up mgl32.Vec3, yaw, pitch float32) *Camera {
 	c := &Camera{
-		Position:    pos,
-		WorldUp:     up,
-		Yaw:         yaw,
-		Pitch:       pitch,
-		Speed:       5.0,
-		Sensitivity: 0.1,
+		Position:        pos,
+		WorldUp:         up,
+		Yaw:             yaw,
+		Pitch:           pitch,
+		Speed:           5.0,
+		Sensitivity:     0.1,
+		SprintFactor:    2.0,
+		GamepadDeadzone: 0.15,
+		Distance:        10.0,
+		MinDistance:     1.0,
+		MaxDistance:     50.0,
 		// sensible defaults for projection; call SetAspect() to tune aspect ratio
-		FOV:    45.0,
-		Aspect: 4.0 / 3.0,
-		Near:   0.1,
-		Far:    100.0,
+		FOV:      45.0,
+		Aspect:   4.0 / 3.0,
+		Near:     0.1,
+		Far:      100.0,
+		FOVSpeed: 8.0,
 	}
 	c.updateCameraVectors()
 	return c
@@ -54,37 +118,309 @@ func (c *Camera) SetAspect(aspect float32) {
 
 // ProcessKeyboard moves the camera using WASD booleans and delta time (seconds).
 func (c *Camera) ProcessKeyboard(forward, backward, left, right bool, deltaTime float32) {
+	c.ProcessKeyboardEx(forward, backward, left, right, false, false, false, deltaTime)
+}
+
+// ProcessKeyboardEx is ProcessKeyboard plus up/down movement along WorldUp and
+// a sprint modifier that scales Speed by SprintFactor.
+func (c *Camera) ProcessKeyboardEx(forward, backward, left, right, up, down, sprint bool, deltaTime float32) {
 	velocity := c.Speed * deltaTime
+	if sprint {
+		velocity *= c.SprintFactor
+	}
+	var move mgl32.Vec3
 	if forward {
-		c.Position = c.Position.Add(c.Front.Mul(velocity))
+		move = move.Add(c.Front.Mul(velocity))
 	}
 	if backward {
-		c.Position = c.Position.Sub(c.Front.Mul(velocity))
+		move = move.Sub(c.Front.Mul(velocity))
 	}
 	if left {
-		c.Position = c.Position.Sub(c.Right.Mul(velocity))
+		move = move.Sub(c.Right.Mul(velocity))
 	}
 	if right {
-		c.Position = c.Position.Add(c.Right.Mul(velocity))
+		move = move.Add(c.Right.Mul(velocity))
+	}
+	if up {
+		move = move.Add(c.WorldUp.Mul(velocity))
+	}
+	if down {
+		move = move.Sub(c.WorldUp.Mul(velocity))
+	}
+
+	c.applyMove(move)
+}
+
+// ProcessGamepad moves with the left stick and looks with the right stick.
+// Axes are in [-1, 1] with negative Y meaning up, as raylib reports them.
+// Stick input inside GamepadDeadzone is ignored.
+func (c *Camera) ProcessGamepad(leftX, leftY, rightX, rightY, deltaTime float32) {
+	leftX, leftY = applyDeadzone(leftX, leftY, c.GamepadDeadzone)
+	rightX, rightY = applyDeadzone(rightX, rightY, c.GamepadDeadzone)
+
+	velocity := c.Speed * deltaTime
+	move := c.Front.Mul(-leftY * velocity).Add(c.Right.Mul(leftX * velocity))
+	if move != (mgl32.Vec3{}) {
+		c.applyMove(move)
+	}
+
+	if rightX != 0 || rightY != 0 {
+		// treat full tilt like gamepadLookRate pixels/s of mouse movement
+		dx, dy := rightX*gamepadLookRate*deltaTime, rightY*gamepadLookRate*deltaTime
+		if c.Mode == ModeOrbit {
+			c.OrbitMouse(dx, dy)
+		} else {
+			c.ProcessMouse(dx, dy)
+		}
+	}
+}
+
+// internal: move the camera (or its smoothing target), respecting bounds.
+// In orbit mode the target is panned instead so the camera stays on the sphere.
+func (c *Camera) applyMove(move mgl32.Vec3) {
+	if c.Mode == ModeOrbit {
+		c.Target = c.clampToBounds(c.Target.Add(move))
+		c.updateOrbitPosition()
+		return
+	}
+	if c.smoothing > 0 {
+		c.targetPos = c.clampToBounds(c.targetPos.Add(move))
+		return
+	}
+	c.Position = c.clampToBounds(c.Position.Add(move))
+}
+
+// internal: radial deadzone, rescaled so output ramps up from 0 at the edge
+func applyDeadzone(x, y, deadzone float32) (float32, float32) {
+	mag := float32(math.Hypot(float64(x), float64(y)))
+	if mag <= deadzone {
+		return 0, 0
+	}
+	scale := (mag - deadzone) / (1 - deadzone) / mag
+	if mag > 1 {
+		scale = 1 / mag
+	}
+	return x * scale, y * scale
+}
+
+// SetBounds keeps keyboard movement inside the box [min, max]. Corners given
+// in the wrong order are swapped per axis.
+func (c *Camera) SetBounds(min, max mgl32.Vec3) {
+	for i := range min {
+		if min[i] > max[i] {
+			min[i], max[i] = max[i], min[i]
+		}
+	}
+	c.hasBounds = true
+	c.boundsMin = min
+	c.boundsMax = max
+}
+
+// ClearBounds removes the movement box set by SetBounds.
+func (c *Camera) ClearBounds() {
+	c.hasBounds = false
+}
+
+// internal: clamp p component-wise into the bounds, if any
+func (c *Camera) clampToBounds(p mgl32.Vec3) mgl32.Vec3 {
+	if !c.hasBounds {
+		return p
 	}
+	for i := range p {
+		p[i] = mgl32.Clamp(p[i], c.boundsMin[i], c.boundsMax[i])
+	}
+	return p
 }
 
 // ProcessMouse adjusts yaw/pitch from mouse delta (dx,dy) in pixels.
 // Use small sensitivity for sane rotation.
 func (c *Camera) ProcessMouse(dx, dy float32) {
+	if c.smoothing > 0 {
+		c.targetYaw += dx * c.Sensitivity
+		c.targetPitch = clampPitch(c.targetPitch - dy*c.Sensitivity)
+		return
+	}
+
 	c.Yaw += dx * c.Sensitivity
-	c.Pitch -= dy * c.Sensitivity
+	c.Pitch = clampPitch(c.Pitch - dy*c.Sensitivity)
 
-	if c.Pitch > 89.0 {
-		c.Pitch = 89.0
+	c.updateCameraVectors()
+	//log.Printf("Yaw=%.2f Pitch=%.2f Front=%v\n", c.Yaw, c.Pitch, c.Front)
+
+}
+
+// ProcessRoll banks the camera by delta degrees around its view direction.
+func (c *Camera) ProcessRoll(delta float32) {
+	c.Roll += delta
+	c.updateCameraVectors()
+}
+
+// FocusOn moves the camera so target sits distance units in front of it along
+// the current view direction. A zero transition jumps there immediately,
+// otherwise the move is animated by Update over the transition duration.
+// In orbit mode target becomes the new Target and distance the new Distance
+// (clamped to [MinDistance, MaxDistance]).
+func (c *Camera) FocusOn(target mgl32.Vec3, distance float32, transition time.Duration) {
+	if c.Mode == ModeOrbit {
+		c.focusOrbit(target, distance, transition)
+		return
 	}
-	if c.Pitch < -89.0 {
-		c.Pitch = -89.0
+	dest := target.Sub(c.Front.Mul(distance))
+	if transition <= 0 {
+		c.Position = dest
+		c.targetPos = dest
+		c.focusing = false
+		return
 	}
+	c.focusFrom = c.Position
+	c.focusTo = dest
+	c.focusElapsed = 0
+	c.focusDuration = float32(transition.Seconds())
+	c.focusing = true
+}
 
-	c.updateCameraVectors()
-	//log.Printf("Yaw=%.2f Pitch=%.2f Front=%v\n", c.Yaw, c.Pitch, c.Front)
+// internal: FocusOn for orbit mode, moving the orbit instead of Position
+func (c *Camera) focusOrbit(target mgl32.Vec3, distance float32, transition time.Duration) {
+	distance = mgl32.Clamp(distance, c.MinDistance, c.MaxDistance)
+	if transition <= 0 {
+		c.Target = target
+		c.Distance = distance
+		c.focusing = false
+		c.updateOrbitPosition()
+		return
+	}
+	c.focusFrom = c.Target
+	c.focusTo = target
+	c.focusDistFrom = c.Distance
+	c.focusDistTo = distance
+	c.focusElapsed = 0
+	c.focusDuration = float32(transition.Seconds())
+	c.focusing = true
+}
+
+// Update advances camera animations by deltaTime (seconds). Call once per frame.
+func (c *Camera) Update(deltaTime float32) {
+	if c.focusing {
+		c.focusElapsed += deltaTime
+		t := c.focusElapsed / c.focusDuration
+		if t >= 1 {
+			t = 1
+			c.focusing = false
+		}
+		if c.Mode == ModeOrbit {
+			c.Target = Lerp(c.focusFrom, c.focusTo, t)
+			c.Distance = c.focusDistFrom + (c.focusDistTo-c.focusDistFrom)*t
+			c.updateOrbitPosition()
+		} else {
+			c.Position = Lerp(c.focusFrom, c.focusTo, t)
+			c.targetPos = c.Position
+		}
+	}
+
+	if c.zoomingFOV {
+		k := float32(1)
+		if c.FOVSpeed > 0 {
+			k = 1 - float32(math.Exp(-float64(c.FOVSpeed*deltaTime)))
+		}
+		c.FOV += (c.targetFOV - c.FOV) * k
+		// snap once close enough so it doesn't creep forever
+		if math.Abs(float64(c.targetFOV-c.FOV)) < 0.01 {
+			c.FOV = c.targetFOV
+			c.zoomingFOV = false
+		}
+	}
+
+	if c.smoothing > 0 {
+		// frame-rate independent exponential ease toward the targets
+		k := 1 - float32(math.Exp(-float64(deltaTime/c.smoothing)))
+		if !c.focusing {
+			c.Position = Lerp(c.Position, c.targetPos, k)
+		}
+		c.Yaw += (c.targetYaw - c.Yaw) * k
+		c.Pitch += (c.targetPitch - c.Pitch) * k
+		c.updateCameraVectors()
+		if c.Mode == ModeOrbit {
+			c.updateOrbitPosition()
+		}
+	}
+}
+
+// SetSmoothing sets how long (seconds, roughly) the camera takes to catch up
+// with input when Update is called each frame. 0 applies input instantly.
+func (c *Camera) SetSmoothing(seconds float32) {
+	if seconds < 0 {
+		seconds = 0
+	}
+	c.smoothing = seconds
+	c.targetPos = c.Position
+	c.targetYaw = c.Yaw
+	c.targetPitch = c.Pitch
+}
+
+// SetTargetFOV starts a smooth zoom to fov (degrees, clamped to
+// [MinFOV, MaxFOV]); Update animates FOV toward it at FOVSpeed.
+func (c *Camera) SetTargetFOV(fov float32) {
+	if fov < MinFOV {
+		fov = MinFOV
+	}
+	if fov > MaxFOV {
+		fov = MaxFOV
+	}
+	c.targetFOV = fov
+	c.zoomingFOV = true
+}
 
+// Lerp linearly interpolates between a and b by t (0 = a, 1 = b).
+func Lerp(a, b mgl32.Vec3, t float32) mgl32.Vec3 {
+	return a.Add(b.Sub(a).Mul(t))
+}
+
+// SetMode switches between free and orbit mode. Entering ModeOrbit places the
+// camera on the orbit sphere around Target right away.
+func (c *Camera) SetMode(m Mode) {
+	c.Mode = m
+	if m == ModeOrbit {
+		c.updateOrbitPosition()
+	}
+}
+
+// OrbitMouse adjusts yaw/pitch from mouse delta (dx,dy) and moves the camera
+// around Target so it stays Distance away and looks at it.
+func (c *Camera) OrbitMouse(dx, dy float32) {
+	c.ProcessMouse(dx, dy)
+	c.updateOrbitPosition()
+}
+
+// Zoom changes Distance by delta (positive moves closer, e.g. mouse wheel up),
+// clamped to [MinDistance, MaxDistance].
+func (c *Camera) Zoom(delta float32) {
+	c.Distance -= delta
+	if c.Distance < c.MinDistance {
+		c.Distance = c.MinDistance
+	}
+	if c.Distance > c.MaxDistance {
+		c.Distance = c.MaxDistance
+	}
+	if c.Mode == ModeOrbit {
+		c.updateOrbitPosition()
+	}
+}
+
+// internal: place the camera on the orbit sphere behind Front
+func (c *Camera) updateOrbitPosition() {
+	c.Position = c.Target.Sub(c.Front.Mul(c.Distance))
+	c.targetPos = c.Position
+}
+
+// internal: keep pitch short of straight up/down to avoid flipping
+func clampPitch(pitch float32) float32 {
+	if pitch > 89.0 {
+		return 89.0
+	}
+	if pitch < -89.0 {
+		return -89.0
+	}
+	return pitch
 }
 
 // GetViewMatrix returns the view matrix (mgl32.Mat4) for the current camera transform.
@@ -101,6 +437,30 @@ func (c *Camera) GetProjectionMatrix() mgl32.Mat4 {
 	return mgl32.Perspective(fovyRad, c.Aspect, c.Near, c.Far)
 }
 
+// ScreenPointToRay unprojects the pixel (x,y) of a viewportW x viewportH
+// viewport into a world-space ray starting at Position. dir is normalized.
+// An empty viewport (e.g. a minimized window) yields Front.
+func (c *Camera) ScreenPointToRay(x, y float32, viewportW, viewportH int) (origin, dir mgl32.Vec3) {
+	if viewportW <= 0 || viewportH <= 0 {
+		return c.Position, c.Front
+	}
+
+	// pixel -> normalized device coordinates (y points down on screen)
+	ndcX := 2*x/float32(viewportW) - 1
+	ndcY := 1 - 2*y/float32(viewportH)
+
+	fovyRad := float32(float64(c.FOV) * math.Pi / 180.0)
+	proj := mgl32.Perspective(fovyRad, float32(viewportW)/float32(viewportH), c.Near, c.Far)
+	inv := proj.Mul4(c.GetViewMatrix()).Inv()
+
+	near := inv.Mul4x1(mgl32.Vec4{ndcX, ndcY, -1, 1})
+	far := inv.Mul4x1(mgl32.Vec4{ndcX, ndcY, 1, 1})
+	nearPt := near.Vec3().Mul(1 / near.W())
+	farPt := far.Vec3().Mul(1 / far.W())
+
+	return c.Position, farPt.Sub(nearPt).Normalize()
+}
+
 // internal: recompute front/right/up vectors from yaw/pitch
 func (c *Camera) updateCameraVectors() {
 	// Convert degrees to radians in float64 for math trig functions
@@ -117,4 +477,11 @@ func (c *Camera) updateCameraVectors() {
 	c.Front = front
 	c.Right = front.Cross(c.WorldUp).Normalize()
 	c.Up = c.Right.Cross(c.Front).Normalize()
+
+	if c.Roll != 0 {
+		rollRad := float32(float64(c.Roll) * math.Pi / 180.0)
+		q := mgl32.QuatRotate(rollRad, front)
+		c.Right = q.Rotate(c.Right).Normalize()
+		c.Up = q.Rotate(c.Up).Normalize()
+	}
 }
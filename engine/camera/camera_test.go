@@ -0,0 +1,322 @@
+package camera
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+const eps = 1e-4
+
+func vecNear(a, b mgl32.Vec3, tol float32) bool {
+	return a.Sub(b).Len() <= tol
+}
+
+func TestFocusOnInstant(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	target := mgl32.Vec3{3, 2, -10}
+
+	c.FocusOn(target, 5, 0)
+
+	want := target.Sub(c.Front.Mul(5))
+	if !vecNear(c.Position, want, eps) {
+		t.Errorf("Position = %v, want %v", c.Position, want)
+	}
+}
+
+func TestFocusOnAnimated(t *testing.T) {
+	start := mgl32.Vec3{0, 0, 0}
+	c := NewCamera(start, mgl32.Vec3{0, 1, 0}, -90, 0)
+	target := mgl32.Vec3{3, 2, -10}
+	want := target.Sub(c.Front.Mul(5))
+
+	c.FocusOn(target, 5, time.Second)
+	if c.Position != start {
+		t.Fatalf("animated FocusOn moved the camera before Update: %v", c.Position)
+	}
+
+	c.Update(0.5)
+	half := Lerp(start, want, 0.5)
+	if !vecNear(c.Position, half, eps) {
+		t.Errorf("Position halfway = %v, want %v", c.Position, half)
+	}
+
+	for i := 0; i < 10; i++ {
+		c.Update(0.1)
+	}
+	if !vecNear(c.Position, want, eps) {
+		t.Errorf("Position after transition = %v, want %v", c.Position, want)
+	}
+	if c.focusing {
+		t.Error("still focusing after the transition finished")
+	}
+}
+
+func assertOnOrbit(t *testing.T, c *Camera) {
+	t.Helper()
+	if d := c.Position.Sub(c.Target).Len(); math.Abs(float64(d-c.Distance)) > eps {
+		t.Errorf("|Position - Target| = %v, want Distance %v", d, c.Distance)
+	}
+	if dot := c.Front.Dot(c.Target.Sub(c.Position).Normalize()); math.Abs(float64(dot-1)) > eps {
+		t.Errorf("Front %v doesn't point at Target (dot = %v)", c.Front, dot)
+	}
+}
+
+func TestOrbitStaysOnSphere(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	c.Target = mgl32.Vec3{1, 2, 3}
+	c.SetMode(ModeOrbit)
+	assertOnOrbit(t, c)
+
+	c.OrbitMouse(120, -40)
+	assertOnOrbit(t, c)
+	c.Zoom(3)
+	assertOnOrbit(t, c)
+
+	before := c.Target
+	c.ProcessKeyboardEx(true, false, true, false, true, false, false, 0.5)
+	assertOnOrbit(t, c)
+	c.ProcessGamepad(0.8, -0.6, 0, 0, 0.5)
+	assertOnOrbit(t, c)
+	c.ProcessGamepad(0, 0, 1, 0, 0.5)
+	assertOnOrbit(t, c)
+	c.ProcessGamepad(0, 0, 0.3, -0.7, 0.2)
+	assertOnOrbit(t, c)
+	if c.Target == before {
+		t.Error("translation in orbit mode didn't pan Target")
+	}
+}
+
+func TestKeyboardUpMovesAlongWorldUp(t *testing.T) {
+	// look down a bit so Up differs from WorldUp
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, -30)
+	c.ProcessKeyboardEx(false, false, false, false, true, false, false, 1)
+
+	want := mgl32.Vec3{0, c.Speed, 0}
+	if !vecNear(c.Position, want, eps) {
+		t.Errorf("Position after up = %v, want %v", c.Position, want)
+	}
+}
+
+func TestKeyboardSprintScalesDisplacement(t *testing.T) {
+	walk := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	sprint := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	sprint.SprintFactor = 3
+
+	walk.ProcessKeyboardEx(true, false, false, false, false, false, false, 0.5)
+	sprint.ProcessKeyboardEx(true, false, false, false, false, false, true, 0.5)
+
+	want := walk.Position.Mul(sprint.SprintFactor)
+	if !vecNear(sprint.Position, want, eps) {
+		t.Errorf("sprint Position = %v, want %v (walk %v)", sprint.Position, want, walk.Position)
+	}
+}
+
+func TestScreenPointToRayCenter(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{1, 2, 3}, mgl32.Vec3{0, 1, 0}, 30, -20)
+	origin, dir := c.ScreenPointToRay(400, 300, 800, 600)
+
+	if origin != c.Position {
+		t.Errorf("origin = %v, want %v", origin, c.Position)
+	}
+	if !vecNear(dir, c.Front, 1e-3) {
+		t.Errorf("center ray dir = %v, want Front %v", dir, c.Front)
+	}
+}
+
+func TestScreenPointToRayEmptyViewport(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	for _, vp := range [][2]int{{0, 600}, {800, 0}, {-1, -1}} {
+		_, dir := c.ScreenPointToRay(0, 0, vp[0], vp[1])
+		if dir != c.Front {
+			t.Errorf("viewport %dx%d: dir = %v, want Front", vp[0], vp[1], dir)
+		}
+	}
+}
+
+func TestSmoothingConverges(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	c.SetSmoothing(0.1)
+
+	want := c.Position.Add(c.Front.Mul(c.Speed * 0.5))
+	c.ProcessKeyboard(true, false, false, false, 0.5)
+	c.ProcessMouse(0, -100000) // far past the pitch limit
+
+	if c.Position != (mgl32.Vec3{}) || c.Pitch != 0 {
+		t.Fatalf("smoothed input applied before Update: pos %v pitch %v", c.Position, c.Pitch)
+	}
+
+	c.Update(1.0 / 60)
+	if c.Position == (mgl32.Vec3{}) || c.Pitch <= 0 {
+		t.Fatal("first Update didn't move toward the targets")
+	}
+	for i := 0; i < 300; i++ {
+		c.Update(1.0 / 60)
+	}
+	if !vecNear(c.Position, want, 1e-3) {
+		t.Errorf("Position = %v, want %v", c.Position, want)
+	}
+	if math.Abs(float64(c.Pitch-89)) > 1e-3 {
+		t.Errorf("Pitch = %v, want 89", c.Pitch)
+	}
+}
+
+func TestProcessRoll(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, 30, 20)
+	front, up, right := c.Front, c.Up, c.Right
+
+	c.ProcessRoll(0)
+	if c.Front != front || c.Up != up || c.Right != right {
+		t.Errorf("zero roll changed vectors: front %v up %v right %v", c.Front, c.Up, c.Right)
+	}
+
+	c.ProcessRoll(90)
+	if !vecNear(c.Up, right, eps) {
+		t.Errorf("Up after 90 roll = %v, want old Right %v", c.Up, right)
+	}
+	if !vecNear(c.Right, up.Mul(-1), eps) {
+		t.Errorf("Right after 90 roll = %v, want -old Up %v", c.Right, up.Mul(-1))
+	}
+	if !vecNear(c.Front, front, eps) {
+		t.Errorf("Front after roll = %v, want %v", c.Front, front)
+	}
+}
+
+func TestTargetFOVConverges(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	c.SetTargetFOV(90)
+
+	c.Update(1.0 / 60)
+	if c.FOV <= 45 || c.FOV >= 90 {
+		t.Fatalf("FOV after one frame = %v, want between 45 and 90", c.FOV)
+	}
+	for i := 0; i < 120 && c.zoomingFOV; i++ {
+		c.Update(1.0 / 60)
+	}
+	if c.FOV != 90 || c.zoomingFOV {
+		t.Errorf("FOV = %v (zooming %v) after 2s, want 90", c.FOV, c.zoomingFOV)
+	}
+
+	c.SetTargetFOV(500)
+	c.FOVSpeed = 0
+	c.Update(1.0 / 60)
+	if c.FOV != MaxFOV || c.zoomingFOV {
+		t.Errorf("FOV with FOVSpeed 0 = %v (zooming %v), want an instant jump to %v", c.FOV, c.zoomingFOV, MaxFOV)
+	}
+}
+
+func TestBoundsClampMovement(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	// x corners given in the wrong order
+	c.SetBounds(mgl32.Vec3{2, -1, -3}, mgl32.Vec3{-2, 1, 3})
+
+	c.ProcessKeyboardEx(true, false, false, true, true, false, false, 10)
+	want := mgl32.Vec3{2, 1, -3}
+	if !vecNear(c.Position, want, eps) {
+		t.Errorf("Position = %v, want clamped to %v", c.Position, want)
+	}
+
+	c.ClearBounds()
+	c.ProcessKeyboardEx(true, false, false, false, false, false, false, 1)
+	if c.Position.Z() >= -3 {
+		t.Errorf("Position = %v, still clamped after ClearBounds", c.Position)
+	}
+}
+
+func TestGamepadDeadzone(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	yaw, pitch := c.Yaw, c.Pitch
+
+	// both sticks resting just inside the deadzone
+	c.ProcessGamepad(0.1, -0.1, 0.1, 0.05, 1)
+	if c.Position != (mgl32.Vec3{}) {
+		t.Errorf("Position = %v after stick drift, want unchanged", c.Position)
+	}
+	if c.Yaw != yaw || c.Pitch != pitch {
+		t.Errorf("yaw/pitch = %v/%v after stick drift, want %v/%v", c.Yaw, c.Pitch, yaw, pitch)
+	}
+}
+
+func TestGamepadOutsideDeadzone(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	front := c.Front
+
+	// full forward tilt on the left stick moves Speed units per second
+	c.ProcessGamepad(0, -1, 0, 0, 0.5)
+	want := front.Mul(c.Speed * 0.5)
+	if !vecNear(c.Position, want, eps) {
+		t.Errorf("Position = %v, want %v", c.Position, want)
+	}
+
+	// half tilt right and up on the right stick turns right and looks up
+	yaw, pitch := c.Yaw, c.Pitch
+	c.ProcessGamepad(0, 0, 0.5, -0.5, 0.1)
+	if c.Yaw <= yaw {
+		t.Errorf("Yaw = %v, want greater than %v", c.Yaw, yaw)
+	}
+	if c.Pitch <= pitch {
+		t.Errorf("Pitch = %v, want greater than %v", c.Pitch, pitch)
+	}
+
+	// the deadzone rescales, so input just outside it is small, not a jump
+	x, y := applyDeadzone(0.16, 0, c.GamepadDeadzone)
+	if y != 0 || x <= 0 || x > 0.02 {
+		t.Errorf("applyDeadzone(0.16, 0) = %v, %v; want a small positive x", x, y)
+	}
+}
+
+func TestFocusOnOrbit(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 1, 0}, -90, 0)
+	c.SetMode(ModeOrbit)
+
+	target := mgl32.Vec3{20, 0, 0}
+	c.FocusOn(target, 5, 0)
+	if c.Target != target || c.Distance != 5 {
+		t.Fatalf("Target/Distance = %v/%v, want %v/5", c.Target, c.Distance, target)
+	}
+	assertOnOrbit(t, c)
+
+	// later orbit input must keep the new orbit, not snap back
+	want := c.Position
+	c.Zoom(0)
+	if !vecNear(c.Position, want, eps) {
+		t.Errorf("Position after Zoom(0) = %v, want %v", c.Position, want)
+	}
+
+	next := mgl32.Vec3{0, 10, 0}
+	c.FocusOn(next, 8, time.Second)
+	c.Update(0.5)
+	if !vecNear(c.Target, mgl32.Vec3{10, 5, 0}, eps) || math.Abs(float64(c.Distance-6.5)) > eps {
+		t.Errorf("halfway Target/Distance = %v/%v, want (10,5,0)/6.5", c.Target, c.Distance)
+	}
+	assertOnOrbit(t, c)
+	c.Update(0.6)
+	if c.Target != next || c.Distance != 8 || c.focusing {
+		t.Errorf("after transition Target/Distance = %v/%v (focusing %v), want %v/8", c.Target, c.Distance, c.focusing, next)
+	}
+	assertOnOrbit(t, c)
+}
+
+func TestScreenPointToRayEdge(t *testing.T) {
+	c := NewCamera(mgl32.Vec3{1, 2, 3}, mgl32.Vec3{0, 1, 0}, 30, -20)
+	c.FOV = 60
+	const w, h = 800, 400
+
+	_, dir := c.ScreenPointToRay(w, h/2, w, h)
+
+	// the right edge sits at the horizontal half-FOV, which depends on aspect
+	aspect := float64(w) / float64(h)
+	want := math.Atan(aspect * math.Tan(float64(c.FOV)*math.Pi/360))
+	got := math.Acos(math.Min(1, float64(dir.Dot(c.Front))))
+	if math.Abs(got-want) > 1e-3 {
+		t.Errorf("edge ray is %.4f rad from Front, want %.4f", got, want)
+	}
+	if dir.Dot(c.Right) <= 0 {
+		t.Errorf("edge ray %v doesn't point right of Front", dir)
+	}
+	if math.Abs(float64(dir.Dot(c.Up))) > 1e-3 {
+		t.Errorf("edge ray %v leaves the horizontal plane (dot Up = %v)", dir, dir.Dot(c.Up))
+	}
+}
@@ -0,0 +1,96 @@
+package renderer
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// debugShape is an immediate-mode debug primitive, drawn unlit for one frame.
+type debugShape struct {
+	Type   string
+	From   mgl32.Vec3 // line start, sphere center, or box min corner
+	To     mgl32.Vec3 // line end, or box max corner
+	Radius float32
+	Color  mgl32.Vec4
+}
+
+// DebugLine draws a line between a and b for this frame only.
+func (r *Renderer) DebugLine(a, b mgl32.Vec3, color mgl32.Vec4) {
+	r.debugqueue = append(r.debugqueue, debugShape{
+		Type:  "line",
+		From:  a,
+		To:    b,
+		Color: color,
+	})
+}
+
+// DebugSphere draws a wire sphere for this frame only.
+func (r *Renderer) DebugSphere(center mgl32.Vec3, radius float32, color mgl32.Vec4) {
+	r.debugqueue = append(r.debugqueue, debugShape{
+		Type:   "sphere",
+		From:   center,
+		Radius: radius,
+		Color:  color,
+	})
+}
+
+// DebugAxes draws X (red), Y (green) and Z (blue) axes of length size at pos
+// for this frame only.
+func (r *Renderer) DebugAxes(pos mgl32.Vec3, size float32) {
+	r.DebugLine(pos, pos.Add(mgl32.Vec3{size, 0, 0}), mgl32.Vec4{1, 0, 0, 1})
+	r.DebugLine(pos, pos.Add(mgl32.Vec3{0, size, 0}), mgl32.Vec4{0, 1, 0, 1})
+	r.DebugLine(pos, pos.Add(mgl32.Vec3{0, 0, size}), mgl32.Vec4{0, 0, 1, 1})
+}
+
+// boundingBoxColor is the outline color used by SetDrawBoundingBoxes (rl.Lime).
+var boundingBoxColor = mgl32.Vec4{0, 158.0 / 255.0, 47.0 / 255.0, 1}
+
+// queueBoundingBox queues the axis-aligned box around prim.
+func (r *Renderer) queueBoundingBox(prim Primitive) {
+	half := prim.Size.Mul(0.5)
+	r.debugqueue = append(r.debugqueue, debugShape{
+		Type:  "box",
+		From:  prim.Position.Sub(half),
+		To:    prim.Position.Add(half),
+		Color: boundingBoxColor,
+	})
+}
+
+// GetDebugCount returns the number of debug shapes queued this frame.
+func (r *Renderer) GetDebugCount() int {
+	return len(r.debugqueue)
+}
+
+// drawDebug draws the queued debug shapes on top of the scene, ignoring
+// depth. Must be called inside BeginMode3D with the lighting shader inactive.
+func (r *Renderer) drawDebug() {
+	if len(r.debugqueue) == 0 {
+		return
+	}
+	// lines and wires are batched, so flush around the depth test change or
+	// the batch would be drawn later with depth testing back on
+	rl.DrawRenderBatchActive()
+	rl.DisableDepthTest()
+	defer func() {
+		rl.DrawRenderBatchActive()
+		rl.EnableDepthTest()
+	}()
+
+	for _, d := range r.debugqueue {
+		col := vec4ToColor(d.Color)
+		switch d.Type {
+		case "line":
+			rl.DrawLine3D(
+				rl.Vector3{X: d.From.X(), Y: d.From.Y(), Z: d.From.Z()},
+				rl.Vector3{X: d.To.X(), Y: d.To.Y(), Z: d.To.Z()},
+				col)
+		case "sphere":
+			rl.DrawSphereWires(rl.Vector3{X: d.From.X(), Y: d.From.Y(), Z: d.From.Z()}, d.Radius, 8, 8, col)
+		case "box":
+			rl.DrawBoundingBox(rl.BoundingBox{
+				Min: rl.Vector3{X: d.From.X(), Y: d.From.Y(), Z: d.From.Z()},
+				Max: rl.Vector3{X: d.To.X(), Y: d.To.Y(), Z: d.To.Z()},
+			}, col)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestQueueBoundingBox(t *testing.T) {
+	r := &Renderer{}
+	r.queueBoundingBox(Primitive{Position: mgl32.Vec3{1, 2, 3}, Size: mgl32.Vec3{2, 4, 6}, Type: "cube"})
+
+	if len(r.debugqueue) != 1 {
+		t.Fatalf("debug queue has %d shapes, want 1", len(r.debugqueue))
+	}
+	box := r.debugqueue[0]
+	if box.Type != "box" || box.From != (mgl32.Vec3{0, 0, 0}) || box.To != (mgl32.Vec3{2, 4, 6}) {
+		t.Errorf("box = %+v, want box from (0,0,0) to (2,4,6)", box)
+	}
+	if r.GetPrimCount() != 0 {
+		t.Errorf("GetPrimCount = %d, bounding boxes must not count as prims", r.GetPrimCount())
+	}
+}
+
+func TestDebugQueuePerFrame(t *testing.T) {
+	r := &Renderer{}
+	white := mgl32.Vec4{1, 1, 1, 1}
+	r.DebugLine(mgl32.Vec3{}, mgl32.Vec3{1, 0, 0}, white)
+	r.DebugSphere(mgl32.Vec3{0, 1, 0}, 0.5, white)
+	r.DebugAxes(mgl32.Vec3{}, 2)
+
+	if got := r.GetDebugCount(); got != 5 {
+		t.Fatalf("GetDebugCount = %d, want 5 (line, sphere, 3 axes)", got)
+	}
+	wantTypes := []string{"line", "sphere", "line", "line", "line"}
+	for i, d := range r.debugqueue {
+		if d.Type != wantTypes[i] {
+			t.Errorf("debugqueue[%d].Type = %s, want %s", i, d.Type, wantTypes[i])
+		}
+	}
+
+	// BeginFrame/EndFrame reset the queues for the next frame
+	r.resetQueues()
+	if got := r.GetDebugCount(); got != 0 {
+		t.Errorf("GetDebugCount = %d in the next frame, want 0", got)
+	}
+	r.DebugLine(mgl32.Vec3{}, mgl32.Vec3{0, 0, 1}, white)
+	if got := r.GetDebugCount(); got != 1 {
+		t.Errorf("GetDebugCount = %d after one push, want 1", got)
+	}
+}
@@ -0,0 +1,90 @@
+package renderer
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+const (
+	// dynResStep is how much the render scale moves per frame.
+	dynResStep = 0.05
+	// dynResHeadroom is the fraction of the budget a frame must stay under
+	// before the scale is raised again, so it doesn't oscillate.
+	dynResHeadroom = 0.8
+)
+
+// SetDynamicResolution renders the 3D scene at a reduced internal resolution
+// whenever rendering takes longer than budgetMS, never going below minScale.
+// budgetMS is compared against the time from BeginFrame until EndFrame
+// presents the frame, so it excludes game logic outside those calls, the
+// SetTargetFPS wait and the buffer swap. The UI is always drawn at full
+// resolution. A minScale >= 1 disables it.
+func (r *Renderer) SetDynamicResolution(minScale, budgetMS float32) {
+	if minScale <= 0 {
+		minScale = dynResStep
+	}
+	r.dynResEnabled = minScale < 1 && budgetMS > 0
+	r.dynResMinScale = minScale
+	r.dynResBudgetMS = budgetMS
+	if !r.dynResEnabled {
+		r.renderScale = 1
+	}
+}
+
+// GetRenderScale returns the current internal 3D resolution scale (0..1].
+func (r *Renderer) GetRenderScale() float32 {
+	return r.renderScale
+}
+
+// adjustScale steps scale down when frameMS exceeds budgetMS and back up when
+// there is enough headroom, keeping the result within [minScale, 1].
+func adjustScale(scale, minScale, budgetMS, frameMS float32) float32 {
+	switch {
+	case frameMS > budgetMS:
+		scale -= dynResStep
+	case frameMS < budgetMS*dynResHeadroom:
+		scale += dynResStep
+	}
+	if scale < minScale {
+		scale = minScale
+	}
+	if scale > 1 {
+		scale = 1
+	}
+	return scale
+}
+
+// beginScene redirects 3D drawing into the scaled render target when dynamic
+// resolution is active. It returns false if drawing goes straight to the window.
+func (r *Renderer) beginScene() bool {
+	if !r.dynResEnabled {
+		return false
+	}
+	r.renderScale = adjustScale(r.renderScale, r.dynResMinScale, r.dynResBudgetMS, r.renderMS)
+	if r.renderScale >= 1 {
+		return false
+	}
+
+	w := int32(float32(r.width) * r.renderScale)
+	h := int32(float32(r.height) * r.renderScale)
+	if r.sceneTarget.ID == 0 || r.sceneTarget.Texture.Width != w || r.sceneTarget.Texture.Height != h {
+		if r.sceneTarget.ID != 0 {
+			rl.UnloadRenderTexture(r.sceneTarget)
+		}
+		r.sceneTarget = rl.LoadRenderTexture(w, h)
+	}
+
+	rl.BeginTextureMode(r.sceneTarget)
+	rl.ClearBackground(vec4ToColor(r.clearColor))
+	return true
+}
+
+// endScene upscales the render target onto the window.
+func (r *Renderer) endScene() {
+	rl.EndTextureMode()
+
+	tex := r.sceneTarget.Texture
+	// Render textures are stored upside down, so flip the source rect
+	src := rl.Rectangle{X: 0, Y: 0, Width: float32(tex.Width), Height: -float32(tex.Height)}
+	dst := rl.Rectangle{X: 0, Y: 0, Width: float32(r.width), Height: float32(r.height)}
+	rl.DrawTexturePro(tex, src, dst, rl.Vector2{}, 0, rl.White)
+}
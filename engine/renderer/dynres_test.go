@@ -0,0 +1,38 @@
+package renderer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAdjustScale(t *testing.T) {
+	const minScale, budget = 0.5, 16
+	frames := []struct {
+		frameMS float32
+		want    float32
+	}{
+		{10, 1},    // under budget at full scale stays at 1
+		{20, 0.95}, // over budget steps down
+		{20, 0.9},
+		{15, 0.9}, // inside the headroom band holds
+		{30, 0.85},
+		{30, 0.8},
+		{30, 0.75},
+		{30, 0.7},
+		{30, 0.65},
+		{30, 0.6},
+		{30, 0.55},
+		{30, 0.5},
+		{30, 0.5}, // clamped to minScale
+		{5, 0.55}, // enough headroom steps back up
+		{5, 0.6},
+	}
+
+	scale := float32(1)
+	for i, f := range frames {
+		scale = adjustScale(scale, minScale, budget, f.frameMS)
+		if math.Abs(float64(scale-f.want)) > 1e-4 {
+			t.Fatalf("frame %d (%.0f ms): scale = %v, want %v", i, f.frameMS, scale, f.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package renderer
+
+import (
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+const (
+	// raylib's default clip distances (RL_CULL_DISTANCE_NEAR/FAR) used by BeginMode3D.
+	cullNear = 0.01
+	cullFar  = 1000.0
+)
+
+// plane is a frustum plane where points p with Normal.Dot(p)+D >= 0 are inside.
+type plane struct {
+	Normal mgl32.Vec3
+	D      float32
+}
+
+// SetFrustumCulling toggles skipping primitives outside the camera frustum.
+func (r *Renderer) SetFrustumCulling(enabled bool) {
+	r.frustumCulling = enabled
+}
+
+// GetCulledCount returns how many primitives were culled last frame.
+func (r *Renderer) GetCulledCount() int {
+	return r.culledCount
+}
+
+// cameraViewProj builds the same view-projection matrix raylib uses for rlCam.
+// For orthographic cameras Fovy is the view height in world units.
+func cameraViewProj(rlCam rl.Camera, aspect float32) mgl32.Mat4 {
+	pos := mgl32.Vec3{rlCam.Position.X, rlCam.Position.Y, rlCam.Position.Z}
+	target := mgl32.Vec3{rlCam.Target.X, rlCam.Target.Y, rlCam.Target.Z}
+	up := mgl32.Vec3{rlCam.Up.X, rlCam.Up.Y, rlCam.Up.Z}
+
+	var proj mgl32.Mat4
+	if rlCam.Projection == rl.CameraOrthographic {
+		top := rlCam.Fovy / 2
+		right := top * aspect
+		proj = mgl32.Ortho(-right, right, -top, top, cullNear, cullFar)
+	} else {
+		fovyRad := float32(float64(rlCam.Fovy) * math.Pi / 180.0)
+		proj = mgl32.Perspective(fovyRad, aspect, cullNear, cullFar)
+	}
+	return proj.Mul4(mgl32.LookAtV(pos, target, up))
+}
+
+// frustumPlanes extracts the six normalized frustum planes (left, right,
+// bottom, top, near, far) from a view-projection matrix.
+func frustumPlanes(vp mgl32.Mat4) [6]plane {
+	r0, r1, r2, r3 := vp.Row(0), vp.Row(1), vp.Row(2), vp.Row(3)
+	raw := [6]mgl32.Vec4{
+		r3.Add(r0), // left
+		r3.Sub(r0), // right
+		r3.Add(r1), // bottom
+		r3.Sub(r1), // top
+		r3.Add(r2), // near
+		r3.Sub(r2), // far
+	}
+
+	var planes [6]plane
+	for i, p := range raw {
+		n := p.Vec3()
+		l := n.Len()
+		planes[i] = plane{Normal: n.Mul(1 / l), D: p.W() / l}
+	}
+	return planes
+}
+
+// sphereInFrustum reports whether a sphere is at least partly inside the
+// frustum. It only returns false when the sphere is fully outside one plane.
+func sphereInFrustum(planes [6]plane, center mgl32.Vec3, radius float32) bool {
+	for _, p := range planes {
+		if p.Normal.Dot(center)+p.D < -radius {
+			return false
+		}
+	}
+	return true
+}
+
+// primBoundingRadius returns the radius of the sphere enclosing a primitive's box.
+func primBoundingRadius(prim Primitive) float32 {
+	return prim.Size.Len() / 2
+}
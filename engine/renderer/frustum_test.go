@@ -0,0 +1,107 @@
+package renderer
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// testPlanes returns the frustum of a camera at the origin looking down -Z.
+func testPlanes() [6]plane {
+	cam := rl.Camera{
+		Position:   rl.NewVector3(0, 0, 0),
+		Target:     rl.NewVector3(0, 0, -1),
+		Up:         rl.NewVector3(0, 1, 0),
+		Fovy:       90,
+		Projection: rl.CameraPerspective,
+	}
+	return frustumPlanes(cameraViewProj(cam, 1))
+}
+
+func TestFrustumPlanes(t *testing.T) {
+	planes := testPlanes()
+	for i, p := range planes {
+		if l := p.Normal.Len(); math.Abs(float64(l-1)) > 1e-4 {
+			t.Errorf("plane %d normal length = %v, want 1", i, l)
+		}
+	}
+
+	// 90 degree fov with aspect 1: the side planes lean 45 degrees
+	s := float32(math.Sqrt2 / 2)
+	wantNormals := [4]mgl32.Vec3{
+		{s, 0, -s},  // left
+		{-s, 0, -s}, // right
+		{0, s, -s},  // bottom
+		{0, -s, -s}, // top
+	}
+	for i, want := range wantNormals {
+		if planes[i].Normal.Sub(want).Len() > 1e-4 || math.Abs(float64(planes[i].D)) > 1e-4 {
+			t.Errorf("plane %d = %+v, want normal %v through the origin", i, planes[i], want)
+		}
+	}
+
+	// far.D loses precision in float32 at 1000 units, hence the loose bound
+	near, far := planes[4], planes[5]
+	if near.Normal.Sub(mgl32.Vec3{0, 0, -1}).Len() > 1e-4 || math.Abs(float64(near.D+cullNear)) > 1e-4 {
+		t.Errorf("near plane = %+v", near)
+	}
+	if far.Normal.Sub(mgl32.Vec3{0, 0, 1}).Len() > 1e-4 || math.Abs(float64(far.D-cullFar)) > 2 {
+		t.Errorf("far plane = %+v", far)
+	}
+}
+
+func TestSphereInFrustum(t *testing.T) {
+	planes := testPlanes()
+	tests := []struct {
+		name   string
+		center mgl32.Vec3
+		radius float32
+		want   bool
+	}{
+		{"ahead", mgl32.Vec3{0, 0, -10}, 1, true},
+		{"behind", mgl32.Vec3{0, 0, 10}, 1, false},
+		{"far left", mgl32.Vec3{-30, 0, -10}, 1, false},
+		{"straddling left plane", mgl32.Vec3{-10.5, 0, -10}, 1, true},
+		{"above", mgl32.Vec3{0, 30, -10}, 1, false},
+		{"past far plane", mgl32.Vec3{0, 0, -2000}, 1, false},
+		{"around the camera", mgl32.Vec3{0, 0, 0}, 5, true},
+	}
+	for _, tt := range tests {
+		if got := sphereInFrustum(planes, tt.center, tt.radius); got != tt.want {
+			t.Errorf("%s: sphereInFrustum(%v, %v) = %v, want %v", tt.name, tt.center, tt.radius, got, tt.want)
+		}
+	}
+}
+
+func TestSphereInFrustumOrthographic(t *testing.T) {
+	// a 20 unit tall ortho view; as a perspective fovy of 20 degrees it
+	// would only be about 3.5 units tall at z=-10
+	cam := rl.Camera{
+		Position:   rl.NewVector3(0, 0, 0),
+		Target:     rl.NewVector3(0, 0, -1),
+		Up:         rl.NewVector3(0, 1, 0),
+		Fovy:       20,
+		Projection: rl.CameraOrthographic,
+	}
+	planes := frustumPlanes(cameraViewProj(cam, 2))
+
+	tests := []struct {
+		name   string
+		center mgl32.Vec3
+		want   bool
+	}{
+		{"ahead", mgl32.Vec3{0, 0, -10}, true},
+		{"near the top edge", mgl32.Vec3{0, 9, -10}, true},
+		{"near the right edge", mgl32.Vec3{19, 0, -500}, true},
+		{"above the view", mgl32.Vec3{0, 12, -10}, false},
+		{"right of the view", mgl32.Vec3{22, 0, -10}, false},
+		{"behind", mgl32.Vec3{0, 0, 10}, false},
+	}
+	for _, tt := range tests {
+		if got := sphereInFrustum(planes, tt.center, 1); got != tt.want {
+			t.Errorf("%s: sphereInFrustum(%v, 1) = %v, want %v", tt.name, tt.center, got, tt.want)
+		}
+	}
+}
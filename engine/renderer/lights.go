@@ -0,0 +1,105 @@
+package renderer
+
+import (
+	"log"
+	"sort"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+const (
+	// maxShaderLights must match the size of the lights array in lighting.fs.
+	maxShaderLights = 32
+	// defaultLightBudget is the light budget a new Renderer starts with.
+	defaultLightBudget = 8
+)
+
+// LightHandle identifies a static light added with AddStaticLight.
+type LightHandle int
+
+type staticLight struct {
+	handle LightHandle
+	light  Light
+}
+
+// AddStaticLight adds a light that persists across frames until removed with
+// RemoveLight. Unlike AddLight it doesn't need to be re-added every frame.
+func (r *Renderer) AddStaticLight(pos, color mgl32.Vec3, intensity float32, lightType int) LightHandle {
+	r.nextLightHandle++
+	h := r.nextLightHandle
+	r.staticLights = append(r.staticLights, staticLight{
+		handle: h,
+		light: Light{
+			Position:  pos,
+			Color:     color,
+			Intensity: intensity,
+			Type:      lightType,
+		},
+	})
+	return h
+}
+
+// RemoveLight removes a static light. It returns false if h is unknown.
+func (r *Renderer) RemoveLight(h LightHandle) bool {
+	for i, sl := range r.staticLights {
+		if sl.handle == h {
+			r.staticLights = append(r.staticLights[:i], r.staticLights[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// SetLightBudget caps how many lights, static and per-frame together, have
+// their uniforms uploaded each frame. When more lights are queued, the ones
+// contributing the most light at the camera win, so a static light can be
+// dropped too. Values are clamped to [0, maxShaderLights].
+func (r *Renderer) SetLightBudget(n int) {
+	if n < 0 {
+		n = 0
+	}
+	if n > maxShaderLights {
+		n = maxShaderLights
+	}
+	r.lightBudget = n
+}
+
+// GetLightBudget returns the current light budget (static plus per-frame).
+func (r *Renderer) GetLightBudget() int {
+	return r.lightBudget
+}
+
+// selectLights picks the static and per-frame lights to upload this frame and
+// logs when the budget starts being exceeded.
+func (r *Renderer) selectLights(camPos mgl32.Vec3) []Light {
+	r.frameLights = append(r.frameLights[:0], r.lights...)
+	for _, sl := range r.staticLights {
+		r.frameLights = append(r.frameLights, sl.light)
+	}
+
+	over := len(r.frameLights) > r.lightBudget
+	if over && !r.overBudget {
+		log.Printf("renderer: %d lights queued, light budget is %d; dropping lowest priority", len(r.frameLights), r.lightBudget)
+	}
+	r.overBudget = over
+	return prioritizeLights(r.frameLights, camPos, r.lightBudget)
+}
+
+// prioritizeLights sorts lights by their estimated contribution at camPos and
+// returns the n highest. The slice is reordered in place.
+func prioritizeLights(lights []Light, camPos mgl32.Vec3, n int) []Light {
+	if n > len(lights) {
+		n = len(lights)
+	}
+	sort.SliceStable(lights, func(i, j int) bool {
+		return lightPriority(lights[i], camPos) > lightPriority(lights[j], camPos)
+	})
+	return lights[:n]
+}
+
+// lightPriority estimates how much a light contributes at pos, using the same
+// attenuation curve as lighting.fs.
+func lightPriority(l Light, pos mgl32.Vec3) float32 {
+	d := l.Position.Sub(pos).Len()
+	return l.Intensity / (1.0 + 0.09*d + 0.032*d*d)
+}
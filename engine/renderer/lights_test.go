@@ -0,0 +1,90 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestSelectLightsClosestN(t *testing.T) {
+	r := &Renderer{lightBudget: 2}
+	for _, x := range []float32{30, 2, 20, 1, 10} {
+		r.AddLight(mgl32.Vec3{x, 0, 0}, mgl32.Vec3{1, 1, 1}, 1, 0)
+	}
+
+	got := r.selectLights(mgl32.Vec3{0, 0, 0})
+	if len(got) != 2 {
+		t.Fatalf("selected %d lights, want 2", len(got))
+	}
+	if got[0].Position.X() != 1 || got[1].Position.X() != 2 {
+		t.Errorf("selected lights at x=%v, x=%v; want 1, 2", got[0].Position.X(), got[1].Position.X())
+	}
+}
+
+func TestSelectLightsUnderBudget(t *testing.T) {
+	r := &Renderer{lightBudget: 8}
+	r.AddLight(mgl32.Vec3{5, 0, 0}, mgl32.Vec3{1, 1, 1}, 1, 0)
+	r.AddLight(mgl32.Vec3{1, 0, 0}, mgl32.Vec3{1, 1, 1}, 1, 0)
+
+	if got := r.selectLights(mgl32.Vec3{}); len(got) != 2 {
+		t.Errorf("selected %d lights, want 2", len(got))
+	}
+}
+
+func TestPrioritizeLightsByContribution(t *testing.T) {
+	white := mgl32.Vec3{1, 1, 1}
+	lights := []Light{
+		{Position: mgl32.Vec3{1, 0, 0}, Color: white, Intensity: 1},    // near, dim
+		{Position: mgl32.Vec3{20, 0, 0}, Color: white, Intensity: 100}, // far, bright
+		{Position: mgl32.Vec3{40, 0, 0}, Color: white, Intensity: 1},   // far, dim
+	}
+
+	got := prioritizeLights(lights, mgl32.Vec3{}, 1)
+	if len(got) != 1 {
+		t.Fatalf("selected %d lights, want 1", len(got))
+	}
+	if got[0].Intensity != 100 {
+		t.Errorf("selected light with intensity %v at %v, want the intensity 100 light", got[0].Intensity, got[0].Position)
+	}
+
+	got = prioritizeLights(lights, mgl32.Vec3{}, 2)
+	if got[1].Position.X() != 1 {
+		t.Errorf("second light at x=%v, want the near light at x=1", got[1].Position.X())
+	}
+}
+
+func TestStaticLightSurvivesFrames(t *testing.T) {
+	r := &Renderer{lightBudget: 8}
+	h := r.AddStaticLight(mgl32.Vec3{0, 5, 0}, mgl32.Vec3{1, 1, 1}, 1, 0)
+	r.AddLight(mgl32.Vec3{1, 0, 0}, mgl32.Vec3{1, 0, 0}, 1, 0)
+
+	// the per-frame light is only added for the first frame; EndFrame drops
+	// r.lights after uploading them
+	wantCounts := []int{2, 1}
+	for frame, want := range wantCounts {
+		got := r.selectLights(mgl32.Vec3{})
+		r.lights = r.lights[:0]
+		if len(got) != want {
+			t.Fatalf("frame %d: selected %d lights, want %d", frame, len(got), want)
+		}
+		found := false
+		for _, l := range got {
+			if l.Position == (mgl32.Vec3{0, 5, 0}) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("frame %d: static light missing", frame)
+		}
+	}
+
+	if !r.RemoveLight(h) {
+		t.Fatal("RemoveLight returned false for a live handle")
+	}
+	if got := r.selectLights(mgl32.Vec3{}); len(got) != 0 {
+		t.Errorf("selected %d lights after RemoveLight, want 0", len(got))
+	}
+	if r.RemoveLight(h) {
+		t.Error("RemoveLight returned true for a removed handle")
+	}
+}
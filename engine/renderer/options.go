@@ -0,0 +1,78 @@
+package renderer
+
+import (
+	"log"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Options configures a Renderer created with NewRendererWithOptions.
+type Options struct {
+	// MSAASamples enables multisample anti-aliasing when >= 2. raylib only
+	// exposes a 4x hint, so any value >= 2 requests 4x MSAA.
+	MSAASamples int
+
+	// Tessellation of the generated sphere and cylinder meshes. Higher
+	// counts look smoother but cost more vertices per draw.
+	SphereRings    int
+	SphereSlices   int
+	CylinderSlices int
+}
+
+// minMeshSegments is the fewest segments a generated mesh can use.
+const minMeshSegments = 3
+
+// DefaultOptions returns the options NewRenderer uses.
+func DefaultOptions() Options {
+	return Options{
+		MSAASamples:    0,
+		SphereRings:    16,
+		SphereSlices:   16,
+		CylinderSlices: 16,
+	}
+}
+
+// ConfigFlags maps the options to raylib window config flags.
+func (o Options) ConfigFlags() uint32 {
+	var flags uint32
+	if o.MSAASamples >= 2 {
+		flags |= rl.FlagMsaa4xHint
+	}
+	return flags
+}
+
+// sphereSegments returns the rings/slices passed to GenMeshSphere.
+func (o Options) sphereSegments() (rings, slices int) {
+	return clampSegments(o.SphereRings), clampSegments(o.SphereSlices)
+}
+
+// cylinderSegments returns the slices passed to GenMeshCylinder.
+func (o Options) cylinderSegments() int {
+	return clampSegments(o.CylinderSlices)
+}
+
+func clampSegments(n int) int {
+	if n < minMeshSegments {
+		return minMeshSegments
+	}
+	return n
+}
+
+// ApplyConfigFlags hands the window flags to raylib. raylib reads them when
+// the window is created, so this must be called BEFORE rl.InitWindow;
+// calling it afterwards has no effect on the existing window.
+func (o Options) ApplyConfigFlags() {
+	if flags := o.ConfigFlags(); flags != 0 {
+		rl.SetConfigFlags(flags)
+	}
+}
+
+// NewRendererWithOptions creates a renderer using opts. The window must
+// already exist, and opts.ApplyConfigFlags must have been called before it
+// was created for MSAA to take effect.
+func NewRendererWithOptions(width, height int, opts Options) *Renderer {
+	if opts.MSAASamples >= 2 && !rl.IsWindowState(rl.FlagMsaa4xHint) {
+		log.Printf("renderer: MSAA requested but not enabled; call Options.ApplyConfigFlags before rl.InitWindow")
+	}
+	return newRenderer(width, height, opts)
+}
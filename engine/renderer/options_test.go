@@ -0,0 +1,58 @@
+package renderer
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestDefaultOptions(t *testing.T) {
+	o := DefaultOptions()
+	if o.MSAASamples != 0 {
+		t.Errorf("MSAASamples = %d, want 0", o.MSAASamples)
+	}
+	if o.SphereRings != 16 || o.SphereSlices != 16 || o.CylinderSlices != 16 {
+		t.Errorf("segments = %d/%d/%d, want 16/16/16", o.SphereRings, o.SphereSlices, o.CylinderSlices)
+	}
+	if f := o.ConfigFlags(); f != 0 {
+		t.Errorf("default ConfigFlags = %#x, want 0", f)
+	}
+}
+
+func TestConfigFlagsMSAA(t *testing.T) {
+	tests := []struct {
+		samples int
+		want    uint32
+	}{
+		{0, 0},
+		{1, 0},
+		{2, rl.FlagMsaa4xHint},
+		{4, rl.FlagMsaa4xHint},
+		{8, rl.FlagMsaa4xHint},
+	}
+	for _, tt := range tests {
+		o := Options{MSAASamples: tt.samples}
+		if got := o.ConfigFlags(); got != tt.want {
+			t.Errorf("MSAASamples %d: ConfigFlags = %#x, want %#x", tt.samples, got, tt.want)
+		}
+	}
+}
+
+func TestMeshSegments(t *testing.T) {
+	o := Options{SphereRings: 24, SphereSlices: 12, CylinderSlices: 8}
+	if rings, slices := o.sphereSegments(); rings != 24 || slices != 12 {
+		t.Errorf("sphereSegments = %d, %d; want 24, 12", rings, slices)
+	}
+	if n := o.cylinderSegments(); n != 8 {
+		t.Errorf("cylinderSegments = %d, want 8", n)
+	}
+
+	// too few (or unset) segments are clamped to minMeshSegments
+	o = Options{SphereRings: 0, SphereSlices: 2, CylinderSlices: -5}
+	if rings, slices := o.sphereSegments(); rings != 3 || slices != 3 {
+		t.Errorf("clamped sphereSegments = %d, %d; want 3, 3", rings, slices)
+	}
+	if n := o.cylinderSegments(); n != 3 {
+		t.Errorf("clamped cylinderSegments = %d, want 3", n)
+	}
+}
@@ -0,0 +1,39 @@
+package renderer
+
+import (
+	"fmt"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+const (
+	overlayX          = 10
+	overlayY          = 10
+	overlayLineHeight = 20
+)
+
+var overlayColor = mgl32.Vec4{1, 0, 0, 1}
+
+// DrawDebugOverlay toggles the built-in stats HUD (FPS, frame time, draw
+// calls, prim and light counts) drawn in the top left corner every frame.
+func (r *Renderer) DrawDebugOverlay(enabled bool) {
+	r.overlay = enabled
+}
+
+// overlayLines formats the stat lines shown by the debug overlay.
+func (r *Renderer) overlayLines(fps int32, frameMS float32) []string {
+	return []string{
+		fmt.Sprintf("FPS: %d", fps),
+		fmt.Sprintf("Frame time: %.2f ms", frameMS),
+		fmt.Sprintf("Draw calls: %d", r.drawCalls),
+		fmt.Sprintf("Prims: %d (%d culled)", r.GetPrimCount(), r.culledCount),
+		fmt.Sprintf("Light sources: %d/%d", r.GetLCount(), r.lightBudget),
+	}
+}
+
+// pushOverlay queues the debug overlay lines into the UI queue.
+func (r *Renderer) pushOverlay(fps int32, frameMS float32) {
+	for i, line := range r.overlayLines(fps, frameMS) {
+		r.PushUIText(mgl32.Vec3{overlayX, float32(overlayY + i*overlayLineHeight), 0}, overlayColor, line)
+	}
+}
@@ -0,0 +1,38 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestDebugOverlayLines(t *testing.T) {
+	r := &Renderer{lightBudget: 8, litCount: 3, drawCalls: 12, culledCount: 4}
+	r.queue = make([]Primitive, 7)
+	r.DrawDebugOverlay(true)
+	if !r.overlay {
+		t.Fatal("DrawDebugOverlay(true) didn't enable the overlay")
+	}
+
+	r.pushOverlay(60, 16.5)
+
+	want := []string{
+		"FPS: 60",
+		"Frame time: 16.50 ms",
+		"Draw calls: 12",
+		"Prims: 7 (4 culled)",
+		"Light sources: 3/8",
+	}
+	if r.GetUICount() != len(want) {
+		t.Fatalf("UI queue has %d elements, want %d", r.GetUICount(), len(want))
+	}
+	for i, el := range r.uiqueue {
+		if el.Type != "text" || el.Content != want[i] {
+			t.Errorf("line %d = %s %q, want text %q", i, el.Type, el.Content, want[i])
+		}
+		wantPos := mgl32.Vec3{overlayX, float32(overlayY + i*overlayLineHeight), 0}
+		if el.Position != wantPos {
+			t.Errorf("line %d at %v, want %v", i, el.Position, wantPos)
+		}
+	}
+}
@@ -2,6 +2,9 @@ package renderer
 
 import (
 	"fmt"
+	"log"
+	"math"
+	"sort"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
 	"github.com/go-gl/mathgl/mgl32"
@@ -10,10 +13,56 @@ import (
 type Renderer struct {
 	width, height int
 	queue         []Primitive
+	translucent   []Primitive
 	uiqueue       []UIElement
+	debugqueue    []debugShape
 	lights        []Light
+	frameLights   []Light
+	lightBudget   int
+	litCount      int
+	overBudget    bool
 	shader        rl.Shader
 	cubeModel     rl.Model
+	sphereModel   rl.Model
+	cylModel      rl.Model
+	planeModel    rl.Model
+	warnedTypes   map[string]bool
+	opts          Options
+	drawCalls     int
+	overlay       bool
+
+	// debug draw modes
+	wireframe     bool
+	boundingBoxes bool
+
+	// frustum culling
+	frustumCulling bool
+	culledCount    int
+
+	// dynamic resolution
+	dynResEnabled  bool
+	dynResMinScale float32
+	dynResBudgetMS float32
+	renderScale    float32
+	sceneTarget    rl.RenderTexture2D
+	frameStart     float64 // rl.GetTime() at BeginFrame
+	renderMS       float32 // BeginFrame to EndDrawing last frame, see SetDynamicResolution
+
+	// textures loaded with LoadTexture, handle = index+1
+	textures       []rl.Texture2D
+	texturePaths   map[string]TextureHandle
+	warnedTextures map[TextureHandle]bool
+
+	// background
+	clearColor   mgl32.Vec4
+	hasSkybox    bool
+	skyboxModel  rl.Model
+	skyboxShader rl.Shader
+	skyboxTex    rl.Texture2D
+
+	// lights that survive across frames
+	staticLights    []staticLight
+	nextLightHandle LightHandle
 }
 
 type Primitive struct {
@@ -22,6 +71,7 @@ type Primitive struct {
 	Rotation mgl32.Quat
 	Color    mgl32.Vec4
 	Type     string
+	Texture  TextureHandle // 0 = vertex color only
 }
 
 type UIElement struct {
@@ -31,6 +81,11 @@ type UIElement struct {
 	Color    mgl32.Vec4
 	Content  string
 	Type     string
+	FontSize int32
+	Align    TextAlign
+	// line only
+	End       mgl32.Vec3
+	Thickness float32
 }
 
 type Light struct {
@@ -41,6 +96,10 @@ type Light struct {
 }
 
 func NewRenderer(width, height int) *Renderer {
+	return newRenderer(width, height, DefaultOptions())
+}
+
+func newRenderer(width, height int, opts Options) *Renderer {
 	// Load lighting shader with vertex shader too
 	shader := rl.LoadShader("lighting.vs", "lighting.fs")
 
@@ -49,14 +108,35 @@ func NewRenderer(width, height int) *Renderer {
 	cubeModel := rl.LoadModelFromMesh(cubeMesh)
 	cubeModel.Materials.Shader = shader
 
+	// Unit sized sphere, cylinder and plane so prim.Size scales them like the cube
+	rings, slices := opts.sphereSegments()
+	sphereModel := rl.LoadModelFromMesh(rl.GenMeshSphere(0.5, rings, slices))
+	sphereModel.Materials.Shader = shader
+	cylModel := rl.LoadModelFromMesh(rl.GenMeshCylinder(0.5, 1.0, opts.cylinderSegments()))
+	cylModel.Materials.Shader = shader
+	planeModel := rl.LoadModelFromMesh(rl.GenMeshPlane(1.0, 1.0, 1, 1))
+	planeModel.Materials.Shader = shader
+
 	return &Renderer{
-		width:     width,
-		height:    height,
-		queue:     []Primitive{},
-		uiqueue:   []UIElement{},
-		lights:    []Light{},
-		shader:    shader,
-		cubeModel: cubeModel,
+		width:       width,
+		height:      height,
+		queue:       []Primitive{},
+		uiqueue:     []UIElement{},
+		lights:      []Light{},
+		lightBudget: defaultLightBudget,
+		shader:      shader,
+		cubeModel:   cubeModel,
+		sphereModel: sphereModel,
+		cylModel:    cylModel,
+		planeModel:  planeModel,
+		warnedTypes: map[string]bool{},
+		renderScale: 1,
+		opts:        opts,
+
+		frustumCulling: true,
+		texturePaths:   map[string]TextureHandle{},
+		warnedTextures: map[TextureHandle]bool{},
+		clearColor:     defaultClearColor,
 	}
 }
 
@@ -65,11 +145,17 @@ func (r *Renderer) ShouldClose() bool {
 }
 
 func (r *Renderer) BeginFrame() {
+	r.frameStart = rl.GetTime()
 	rl.BeginDrawing()
-	rl.ClearBackground(rl.NewColor(51, 26, 26, 255))
+	rl.ClearBackground(vec4ToColor(r.clearColor))
+	r.resetQueues()
+}
+
+// resetQueues empties the per-frame draw queues.
+func (r *Renderer) resetQueues() {
 	r.queue = r.queue[:0]
 	r.uiqueue = r.uiqueue[:0]
-
+	r.debugqueue = r.debugqueue[:0]
 }
 
 func (r *Renderer) PushPrimitiveBlock(pos, size mgl32.Vec3, rot mgl32.Quat, color mgl32.Vec4, typetheCube string) {
@@ -82,16 +168,75 @@ func (r *Renderer) PushPrimitiveBlock(pos, size mgl32.Vec3, rot mgl32.Quat, colo
 	})
 }
 
+// PushTexturedPrimitiveBlock is PushPrimitiveBlock with a texture from LoadTexture
+// applied on top of the color.
+func (r *Renderer) PushTexturedPrimitiveBlock(pos, size mgl32.Vec3, rot mgl32.Quat, color mgl32.Vec4, typetheCube string, tex TextureHandle) {
+	r.queue = append(r.queue, Primitive{
+		Position: pos,
+		Size:     size,
+		Rotation: rot,
+		Color:    color,
+		Type:     typetheCube,
+		Texture:  tex,
+	})
+}
+
+// PushUIText queues left-aligned text at font size 20.
 func (r *Renderer) PushUIText(pos mgl32.Vec3, color mgl32.Vec4, content string) {
+	r.PushUITextEx(pos, color, content, defaultFontSize, AlignLeft)
+}
+
+// PushUITextEx queues text at the given font size. pos is the left edge,
+// center or right edge of the text depending on align.
+func (r *Renderer) PushUITextEx(pos mgl32.Vec3, color mgl32.Vec4, content string, size int32, align TextAlign) {
 	r.uiqueue = append(r.uiqueue, UIElement{
 		Position: pos,
 		Color:    color,
 		Content:  content,
 		Type:     "text",
+		FontSize: size,
+		Align:    align,
 	})
 }
 
-// AddLight adds a light to the scene
+// PushDUIText queues a text label anchored at a world position. It is
+// projected to the screen each frame, centered, and hidden when the anchor is
+// behind the camera.
+func (r *Renderer) PushDUIText(worldPos mgl32.Vec3, color mgl32.Vec4, content string) {
+	r.uiqueue = append(r.uiqueue, UIElement{
+		Position: worldPos,
+		Color:    color,
+		Content:  content,
+		Type:     "worldtext",
+		FontSize: defaultFontSize,
+		Align:    AlignCenter,
+	})
+}
+
+// PushUIRect queues a filled rectangle, e.g. a semi-transparent panel behind
+// text. UI elements are drawn in push order.
+func (r *Renderer) PushUIRect(pos, size mgl32.Vec2, color mgl32.Vec4) {
+	r.uiqueue = append(r.uiqueue, UIElement{
+		Position: pos.Vec3(0),
+		Size:     size.Vec3(0),
+		Color:    color,
+		Type:     "rect",
+	})
+}
+
+// PushUILine queues a line from one screen point to another, e.g. for
+// crosshairs or graphs.
+func (r *Renderer) PushUILine(from, to mgl32.Vec3, color mgl32.Vec4, thickness float32) {
+	r.uiqueue = append(r.uiqueue, UIElement{
+		Position:  from,
+		End:       to,
+		Color:     color,
+		Thickness: thickness,
+		Type:      "line",
+	})
+}
+
+// AddLight adds a light to the scene for the next frame only
 func (r *Renderer) AddLight(pos, color mgl32.Vec3, intensity float32, lightType int) {
 	r.lights = append(r.lights, Light{
 		Position:  pos,
@@ -124,8 +269,11 @@ func (r *Renderer) AddSunLight(direction, color mgl32.Vec3, intensity float32) {
 func (r *Renderer) GetPrimCount() int {
 	return len(r.queue)
 }
+
+// GetLCount returns the number of lights (static and per-frame) uploaded to
+// the shader last frame.
 func (r *Renderer) GetLCount() int {
-	return len(r.lights)
+	return r.litCount
 }
 func (r *Renderer) GetUICount() int {
 	return len(r.uiqueue)
@@ -141,7 +289,87 @@ func vec4ToColor(c mgl32.Vec4) rl.Color {
 	)
 }
 
+// drawModel draws model at pos scaled by size with the given tint, as
+// wireframe if enabled. If tex has a non-zero ID it is bound as the albedo
+// map for this draw only.
+func (r *Renderer) drawModel(model rl.Model, pos, size mgl32.Vec3, col rl.Color, tex rl.Texture2D) {
+	if tex.ID != 0 {
+		albedo := model.Materials.GetMap(rl.MapAlbedo)
+		prev := albedo.Texture
+		albedo.Texture = tex
+		defer func() { albedo.Texture = prev }()
+	}
+	draw := rl.DrawModelEx
+	if r.wireframe {
+		draw = rl.DrawModelWiresEx
+	}
+	draw(model,
+		rl.Vector3{X: pos.X(), Y: pos.Y(), Z: pos.Z()},
+		rl.Vector3{X: 0, Y: 0, Z: 0}, // rotation axis
+		0.0,                          // rotation angle
+		rl.Vector3{X: size.X(), Y: size.Y(), Z: size.Z()}, // scale
+		col)
+}
+
+// drawPrimitive draws a single queued primitive with the model for its type.
+func (r *Renderer) drawPrimitive(prim Primitive) {
+	r.drawCalls++
+	col := vec4ToColor(prim.Color)
+	tex, _ := r.lookupTexture(prim.Texture)
+	switch prim.Type {
+	case "cube":
+		// Use model instead of DrawCube for proper lighting
+		r.drawModel(r.cubeModel, prim.Position, prim.Size, col, tex)
+	case "sphere":
+		r.drawModel(r.sphereModel, prim.Position, prim.Size, col, tex)
+	case "cylinder":
+		// GenMeshCylinder starts at y=0, shift it down so it is centered like the cube
+		base := prim.Position.Sub(mgl32.Vec3{0, prim.Size.Y() / 2, 0})
+		r.drawModel(r.cylModel, base, prim.Size, col, tex)
+	case "plane":
+		r.drawModel(r.planeModel, prim.Position, prim.Size, col, tex)
+	case "LightCube":
+		// Use model for light cubes too
+		r.drawModel(r.cubeModel, prim.Position, prim.Size, col, tex)
+
+		// Add this cube as a light source
+		lightColor := mgl32.Vec3{prim.Color.X(), prim.Color.Y(), prim.Color.Z()}
+		r.AddLight(prim.Position, lightColor, 1.0, 1) // Point light with intensity 1.0
+	default:
+		// Unknown types fall back to the cube so mistakes stay visible
+		if !r.warnedTypes[prim.Type] {
+			r.warnedTypes[prim.Type] = true
+			log.Printf("renderer: unknown primitive type %q, drawing as cube", prim.Type)
+		}
+		r.drawModel(r.cubeModel, prim.Position, prim.Size, col, tex)
+	}
+
+	if r.boundingBoxes {
+		// queued so it's drawn unlit on top of the scene, see drawDebug
+		r.queueBoundingBox(prim)
+	}
+}
+
+// SetWireframe draws primitives as wireframes instead of solid.
+func (r *Renderer) SetWireframe(enabled bool) {
+	r.wireframe = enabled
+}
+
+// SetDrawBoundingBoxes outlines each drawn primitive's axis-aligned box.
+func (r *Renderer) SetDrawBoundingBoxes(enabled bool) {
+	r.boundingBoxes = enabled
+}
+
+// sortBackToFront orders prims from farthest to nearest to camPos.
+func sortBackToFront(prims []Primitive, camPos mgl32.Vec3) {
+	sort.SliceStable(prims, func(i, j int) bool {
+		return prims[i].Position.Sub(camPos).LenSqr() > prims[j].Position.Sub(camPos).LenSqr()
+	})
+}
+
 func (r *Renderer) EndFrame(rlCam rl.Camera) {
+	scaled := r.beginScene()
+
 	// Set up lighting uniforms for shader
 	rl.BeginShaderMode(r.shader)
 
@@ -149,18 +377,16 @@ func (r *Renderer) EndFrame(rlCam rl.Camera) {
 	camPos := []float32{rlCam.Position.X, rlCam.Position.Y, rlCam.Position.Z}
 	rl.SetShaderValue(r.shader, rl.GetShaderLocation(r.shader, "viewPos"), camPos, rl.ShaderUniformVec3)
 
-	// Pass number of lights
-	lightCount := int32(len(r.lights))
-	lightCountSlice := []float32{float32(lightCount)}
-	rl.SetShaderValue(r.shader, rl.GetShaderLocation(r.shader, "lightCount"), lightCountSlice, rl.ShaderUniformInt)
+	// Keep only the lights contributing most at the camera, up to the light budget
+	camVec := mgl32.Vec3{rlCam.Position.X, rlCam.Position.Y, rlCam.Position.Z}
+	active := r.selectLights(camVec)
+	r.litCount = len(active)
 
-	// Pass light data (up to 8 lights for performance)
-	maxLights := 8
-	if len(r.lights) > maxLights {
-		r.lights = r.lights[:maxLights]
-	}
+	// Pass number of lights (ShaderUniformInt reads the raw bits of the float)
+	lightCountSlice := []float32{math.Float32frombits(uint32(len(active)))}
+	rl.SetShaderValue(r.shader, rl.GetShaderLocation(r.shader, "lightCount"), lightCountSlice, rl.ShaderUniformInt)
 
-	for i, light := range r.lights {
+	for i, light := range active {
 		posLoc := rl.GetShaderLocation(r.shader, fmt.Sprintf("lights[%d].position", i))
 		colorLoc := rl.GetShaderLocation(r.shader, fmt.Sprintf("lights[%d].color", i))
 		intensityLoc := rl.GetShaderLocation(r.shader, fmt.Sprintf("lights[%d].intensity", i))
@@ -176,53 +402,102 @@ func (r *Renderer) EndFrame(rlCam rl.Camera) {
 	r.lights = r.lights[:0]
 	// Render 3D primitives
 	rl.BeginMode3D(rlCam)
+	r.drawSkybox()
 
+	aspect := float32(rl.GetScreenWidth()) / float32(rl.GetScreenHeight())
+	planes := frustumPlanes(cameraViewProj(rlCam, aspect))
+
+	r.drawCalls = 0
+	r.culledCount = 0
+	r.translucent = r.translucent[:0]
 	for _, prim := range r.queue {
-		col := vec4ToColor(prim.Color)
-		switch prim.Type {
-		case "cube":
-			// Use model instead of DrawCube for proper lighting
-			rl.DrawModelEx(r.cubeModel,
-				rl.Vector3{X: prim.Position.X(), Y: prim.Position.Y(), Z: prim.Position.Z()},
-				rl.Vector3{X: 0, Y: 0, Z: 0}, // rotation axis
-				0.0,                          // rotation angle
-				rl.Vector3{X: prim.Size.X(), Y: prim.Size.Y(), Z: prim.Size.Z()}, // scale
-				col)
-		case "LightCube":
-			// Use model for light cubes too
-			rl.DrawModelEx(r.cubeModel,
-				rl.Vector3{X: prim.Position.X(), Y: prim.Position.Y(), Z: prim.Position.Z()},
-				rl.Vector3{X: 0, Y: 0, Z: 0}, // rotation axis
-				0.0,                          // rotation angle
-				rl.Vector3{X: prim.Size.X(), Y: prim.Size.Y(), Z: prim.Size.Z()}, // scale
-				col)
-
-			// Add this cube as a light source
-			lightColor := mgl32.Vec3{prim.Color.X(), prim.Color.Y(), prim.Color.Z()}
-			r.AddLight(prim.Position, lightColor, 1.0, 1) // Point light with intensity 1.0
+		if r.frustumCulling && !sphereInFrustum(planes, prim.Position, primBoundingRadius(prim)) {
+			r.culledCount++
+			if prim.Type == "LightCube" {
+				// Off-screen light cubes still light the scene
+				lightColor := mgl32.Vec3{prim.Color.X(), prim.Color.Y(), prim.Color.Z()}
+				r.AddLight(prim.Position, lightColor, 1.0, 1)
+			}
+			continue
+		}
+		// Translucent prims are drawn after everything opaque
+		if prim.Color.W() < 1.0 {
+			r.translucent = append(r.translucent, prim)
+			continue
 		}
+		r.drawPrimitive(prim)
 	}
 
-	rl.EndMode3D()
+	// Translucent pass: back to front, blended, without writing depth
+	if len(r.translucent) > 0 {
+		sortBackToFront(r.translucent, camVec)
+		rl.BeginBlendMode(rl.BlendAlpha)
+		rl.DisableDepthMask()
+		for _, prim := range r.translucent {
+			r.drawPrimitive(prim)
+		}
+		rl.EnableDepthMask()
+		rl.EndBlendMode()
+	}
+
+	// Debug shapes go on top of the scene, unlit
 	rl.EndShaderMode()
+	r.drawDebug()
+	rl.EndMode3D()
+
+	if scaled {
+		r.endScene()
+	}
+
+	if r.overlay {
+		r.pushOverlay(rl.GetFPS(), rl.GetFrameTime()*1000)
+	}
 
 	// Render UI elements (no lighting needed)
 	for _, ui := range r.uiqueue {
 		switch ui.Type {
 		case "text":
-			rl.DrawText(ui.Content, int32(ui.Position.X()), int32(ui.Position.Y()), 20, vec4ToColor(ui.Color))
+			x := int32(ui.Position.X()) + alignOffset(rl.MeasureText(ui.Content, ui.FontSize), ui.Align)
+			rl.DrawText(ui.Content, x, int32(ui.Position.Y()), ui.FontSize, vec4ToColor(ui.Color))
+		case "rect":
+			rl.DrawRectangleV(
+				rl.Vector2{X: ui.Position.X(), Y: ui.Position.Y()},
+				rl.Vector2{X: ui.Size.X(), Y: ui.Size.Y()},
+				vec4ToColor(ui.Color))
+		case "worldtext":
+			if behindCamera(rlCam, ui.Position) {
+				continue
+			}
+			screen := rl.GetWorldToScreen(rl.Vector3{X: ui.Position.X(), Y: ui.Position.Y(), Z: ui.Position.Z()}, rlCam)
+			x := int32(screen.X) + alignOffset(rl.MeasureText(ui.Content, ui.FontSize), ui.Align)
+			rl.DrawText(ui.Content, x, int32(screen.Y), ui.FontSize, vec4ToColor(ui.Color))
+		case "line":
+			rl.DrawLineEx(
+				rl.Vector2{X: ui.Position.X(), Y: ui.Position.Y()},
+				rl.Vector2{X: ui.End.X(), Y: ui.End.Y()},
+				ui.Thickness,
+				vec4ToColor(ui.Color))
 		}
 	}
 
+	// measured before EndDrawing, which waits out the SetTargetFPS cap
+	r.renderMS = float32((rl.GetTime() - r.frameStart) * 1000)
 	rl.EndDrawing()
 
 	// clear queues for next frame
-	r.queue = r.queue[:0]
-	r.uiqueue = r.uiqueue[:0]
+	r.resetQueues()
 }
 
 func (r *Renderer) Destroy() {
+	r.unloadTextures()
+	r.unloadSkybox()
 	rl.UnloadModel(r.cubeModel)
+	rl.UnloadModel(r.sphereModel)
+	rl.UnloadModel(r.cylModel)
+	rl.UnloadModel(r.planeModel)
+	if r.sceneTarget.ID != 0 {
+		rl.UnloadRenderTexture(r.sceneTarget)
+	}
 	rl.UnloadShader(r.shader)
 	rl.CloseWindow()
 }
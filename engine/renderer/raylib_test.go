@@ -0,0 +1,46 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestSortBackToFront(t *testing.T) {
+	glass := mgl32.Vec4{1, 1, 1, 0.5}
+	prims := []Primitive{
+		{Position: mgl32.Vec3{0, 0, -2}, Color: glass, Type: "cube"},
+		{Position: mgl32.Vec3{0, 0, -10}, Color: glass, Type: "sphere"},
+		{Position: mgl32.Vec3{3, 0, -5}, Color: glass, Type: "cylinder"},
+	}
+
+	sortBackToFront(prims, mgl32.Vec3{0, 0, 1})
+
+	want := []string{"sphere", "cylinder", "cube"}
+	for i, p := range prims {
+		if p.Type != want[i] {
+			t.Errorf("prims[%d] = %s at %v, want %s", i, p.Type, p.Position, want[i])
+		}
+	}
+}
+
+func TestDebugDrawModeFlags(t *testing.T) {
+	r := &Renderer{}
+	r.PushPrimitiveBlock(mgl32.Vec3{}, mgl32.Vec3{1, 1, 1}, mgl32.QuatIdent(), mgl32.Vec4{1, 1, 1, 1}, "cube")
+	r.PushPrimitiveBlock(mgl32.Vec3{2, 0, 0}, mgl32.Vec3{1, 1, 1}, mgl32.QuatIdent(), mgl32.Vec4{1, 1, 1, 1}, "sphere")
+
+	r.SetWireframe(true)
+	r.SetDrawBoundingBoxes(true)
+	if !r.wireframe || !r.boundingBoxes {
+		t.Errorf("flags = wireframe %v, boundingBoxes %v; want both true", r.wireframe, r.boundingBoxes)
+	}
+	if got := r.GetPrimCount(); got != 2 {
+		t.Errorf("GetPrimCount = %d with debug modes on, want 2", got)
+	}
+
+	r.SetWireframe(false)
+	r.SetDrawBoundingBoxes(false)
+	if r.wireframe || r.boundingBoxes {
+		t.Errorf("flags = wireframe %v, boundingBoxes %v; want both false", r.wireframe, r.boundingBoxes)
+	}
+}
@@ -0,0 +1,112 @@
+package renderer
+
+import (
+	"fmt"
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// defaultClearColor is the background used when no skybox is set.
+var defaultClearColor = mgl32.Vec4{51.0 / 255.0, 26.0 / 255.0, 26.0 / 255.0, 1}
+
+// SetClearColor sets the solid background color the frame is cleared to.
+func (r *Renderer) SetClearColor(color mgl32.Vec4) {
+	r.clearColor = color
+}
+
+// GetClearColor returns the current background color.
+func (r *Renderer) GetClearColor() mgl32.Vec4 {
+	return r.clearColor
+}
+
+// SetSkybox loads six square face images (+X, -X, +Y, -Y, +Z, -Z) into a
+// cubemap drawn behind all geometry. On error the previous background
+// (skybox or clear color) is kept.
+func (r *Renderer) SetSkybox(paths [6]string) error {
+	faces := make([]*rl.Image, 0, len(paths))
+	defer func() {
+		for _, img := range faces {
+			rl.UnloadImage(img)
+		}
+	}()
+
+	var size int32
+	for i, path := range paths {
+		img := rl.LoadImage(path)
+		if img == nil || img.Width == 0 {
+			return fmt.Errorf("renderer: skybox face %d: failed to load %q", i, path)
+		}
+		faces = append(faces, img)
+		if img.Width != img.Height {
+			return fmt.Errorf("renderer: skybox face %d: %q is %dx%d, faces must be square", i, path, img.Width, img.Height)
+		}
+		if i == 0 {
+			size = img.Width
+		} else if img.Width != size {
+			return fmt.Errorf("renderer: skybox face %d: %q is %dpx, expected %dpx", i, path, img.Width, size)
+		}
+	}
+
+	// Stack the faces vertically, which is the order LoadTextureCubemap expects
+	strip := rl.GenImageColor(int(size), int(size)*len(faces), rl.Blank)
+	defer rl.UnloadImage(strip)
+	face := rl.Rectangle{Width: float32(size), Height: float32(size)}
+	for i, img := range faces {
+		dst := rl.Rectangle{Y: float32(int32(i) * size), Width: float32(size), Height: float32(size)}
+		rl.ImageDraw(strip, img, face, dst, rl.White)
+	}
+
+	cubemap := rl.LoadTextureCubemap(strip, rl.CubemapLayoutLineVertical)
+	if cubemap.ID == 0 {
+		return fmt.Errorf("renderer: skybox: failed to create cubemap")
+	}
+
+	// raylib falls back to its default shader when skybox.vs/fs fail to
+	// compile, which would draw an untextured cube over the clear color
+	shader := rl.LoadShader("skybox.vs", "skybox.fs")
+	if shader.ID == rl.GetShaderIdDefault() {
+		rl.UnloadTexture(cubemap)
+		return fmt.Errorf("renderer: skybox: failed to load skybox.vs/skybox.fs")
+	}
+
+	r.unloadSkybox()
+
+	envMap := []float32{math.Float32frombits(uint32(rl.MapCubemap))}
+	rl.SetShaderValue(shader, rl.GetShaderLocation(shader, "environmentMap"), envMap, rl.ShaderUniformInt)
+
+	model := rl.LoadModelFromMesh(rl.GenMeshCube(1.0, 1.0, 1.0))
+	model.Materials.Shader = shader
+	model.Materials.GetMap(rl.MapCubemap).Texture = cubemap
+
+	r.skyboxModel = model
+	r.skyboxShader = shader
+	r.skyboxTex = cubemap
+	r.hasSkybox = true
+	return nil
+}
+
+// drawSkybox draws the cubemap around the camera. Must be called inside
+// BeginMode3D before any other geometry.
+func (r *Renderer) drawSkybox() {
+	if !r.hasSkybox {
+		return
+	}
+	// We're inside the cube, and the sky must never occlude anything
+	rl.DisableBackfaceCulling()
+	rl.DisableDepthMask()
+	rl.DrawModel(r.skyboxModel, rl.Vector3{}, 1.0, rl.White)
+	rl.EnableDepthMask()
+	rl.EnableBackfaceCulling()
+}
+
+func (r *Renderer) unloadSkybox() {
+	if !r.hasSkybox {
+		return
+	}
+	rl.UnloadModel(r.skyboxModel)
+	rl.UnloadTexture(r.skyboxTex)
+	rl.UnloadShader(r.skyboxShader)
+	r.hasSkybox = false
+}
@@ -0,0 +1,24 @@
+package renderer
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestSetClearColor(t *testing.T) {
+	r := &Renderer{clearColor: defaultClearColor}
+	if got := vec4ToColor(r.GetClearColor()); got != rl.NewColor(51, 26, 26, 255) {
+		t.Errorf("default clear color = %v, want 51,26,26,255", got)
+	}
+
+	sky := mgl32.Vec4{0.2, 0.4, 1, 1}
+	r.SetClearColor(sky)
+	if r.GetClearColor() != sky {
+		t.Errorf("GetClearColor = %v, want %v", r.GetClearColor(), sky)
+	}
+	if got := vec4ToColor(r.GetClearColor()); got != rl.NewColor(51, 102, 255, 255) {
+		t.Errorf("clear color as rl.Color = %v, want 51,102,255,255", got)
+	}
+}
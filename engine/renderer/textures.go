@@ -0,0 +1,60 @@
+package renderer
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// TextureHandle refers to a texture loaded with LoadTexture. The zero value
+// means "no texture" and draws with vertex color only.
+type TextureHandle int
+
+// loadTextureFile is rl.LoadTexture, swapped out in tests.
+var loadTextureFile = rl.LoadTexture
+
+// LoadTexture loads the image at path as a texture, returning the cached
+// handle if the same path was loaded before.
+func (r *Renderer) LoadTexture(path string) (TextureHandle, error) {
+	if h, ok := r.texturePaths[path]; ok {
+		return h, nil
+	}
+	if _, err := os.Stat(path); err != nil {
+		return 0, fmt.Errorf("renderer: load texture %q: %w", path, err)
+	}
+	tex := loadTextureFile(path)
+	if tex.ID == 0 {
+		return 0, fmt.Errorf("renderer: load texture %q: unsupported or corrupt image", path)
+	}
+
+	r.textures = append(r.textures, tex)
+	h := TextureHandle(len(r.textures))
+	r.texturePaths[path] = h
+	return h, nil
+}
+
+// lookupTexture resolves h, logging once per handle if it is not loaded.
+func (r *Renderer) lookupTexture(h TextureHandle) (rl.Texture2D, bool) {
+	if h == 0 {
+		return rl.Texture2D{}, false
+	}
+	if int(h) > len(r.textures) || h < 0 {
+		if !r.warnedTextures[h] {
+			r.warnedTextures[h] = true
+			log.Printf("renderer: unknown texture handle %d, using vertex color", h)
+		}
+		return rl.Texture2D{}, false
+	}
+	return r.textures[h-1], true
+}
+
+// unloadTextures frees every cached texture.
+func (r *Renderer) unloadTextures() {
+	for _, tex := range r.textures {
+		rl.UnloadTexture(tex)
+	}
+	r.textures = nil
+	r.texturePaths = map[string]TextureHandle{}
+}
@@ -0,0 +1,82 @@
+package renderer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func newTextureTestRenderer() *Renderer {
+	return &Renderer{
+		texturePaths:   map[string]TextureHandle{},
+		warnedTextures: map[TextureHandle]bool{},
+	}
+}
+
+func TestLoadTextureCachesPath(t *testing.T) {
+	loads := 0
+	defer func(orig func(string) rl.Texture2D) { loadTextureFile = orig }(loadTextureFile)
+	loadTextureFile = func(string) rl.Texture2D {
+		loads++
+		return rl.Texture2D{ID: uint32(100 + loads)}
+	}
+
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.png")
+	b := filepath.Join(dir, "b.png")
+	for _, p := range []string{a, b} {
+		if err := os.WriteFile(p, []byte("png"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := newTextureTestRenderer()
+	h1, err := r.LoadTexture(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := r.LoadTexture(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h1 != h2 {
+		t.Errorf("repeated LoadTexture returned %d then %d", h1, h2)
+	}
+	if loads != 1 {
+		t.Errorf("texture loaded %d times, want 1", loads)
+	}
+
+	h3, err := r.LoadTexture(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h3 == h1 || h3 == 0 {
+		t.Errorf("second path got handle %d (first was %d)", h3, h1)
+	}
+
+	if _, err := r.LoadTexture(filepath.Join(dir, "missing.png")); err == nil {
+		t.Error("LoadTexture of a missing file returned no error")
+	}
+}
+
+func TestLookupTextureUnknownHandle(t *testing.T) {
+	r := newTextureTestRenderer()
+	r.textures = []rl.Texture2D{{ID: 7}}
+
+	if tex, ok := r.lookupTexture(1); !ok || tex.ID != 7 {
+		t.Errorf("lookupTexture(1) = %v, %v; want ID 7, true", tex.ID, ok)
+	}
+	if _, ok := r.lookupTexture(0); ok {
+		t.Error("lookupTexture(0) reported a texture")
+	}
+	for _, h := range []TextureHandle{2, -1} {
+		if _, ok := r.lookupTexture(h); ok {
+			t.Errorf("lookupTexture(%d) reported a texture", h)
+		}
+		if !r.warnedTextures[h] {
+			t.Errorf("unknown handle %d wasn't recorded as warned", h)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package renderer
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// TextAlign controls how UI text is placed relative to its position.
+type TextAlign int
+
+const (
+	AlignLeft TextAlign = iota
+	AlignCenter
+	AlignRight
+)
+
+// defaultFontSize is the font size used by PushUIText.
+const defaultFontSize = 20
+
+// alignOffset returns how far to shift text of the given pixel width on x so
+// it is aligned as requested around its anchor.
+func alignOffset(width int32, align TextAlign) int32 {
+	switch align {
+	case AlignCenter:
+		return -width / 2
+	case AlignRight:
+		return -width
+	default:
+		return 0
+	}
+}
+
+// behindCamera reports whether worldPos is behind (or level with) the camera
+// plane, where projecting it to the screen would give a mirrored position.
+func behindCamera(cam rl.Camera, worldPos mgl32.Vec3) bool {
+	pos := mgl32.Vec3{cam.Position.X, cam.Position.Y, cam.Position.Z}
+	target := mgl32.Vec3{cam.Target.X, cam.Target.Y, cam.Target.Z}
+	forward := target.Sub(pos)
+	return worldPos.Sub(pos).Dot(forward) <= 0
+}
@@ -0,0 +1,98 @@
+package renderer
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestPushUIRectOrder(t *testing.T) {
+	r := &Renderer{}
+	panel := mgl32.Vec4{0, 0, 0, 0.5}
+	r.PushUIRect(mgl32.Vec2{5, 5}, mgl32.Vec2{200, 40}, panel)
+	r.PushUIText(mgl32.Vec3{10, 10, 0}, mgl32.Vec4{1, 1, 1, 1}, "hello")
+
+	if len(r.uiqueue) != 2 {
+		t.Fatalf("UI queue has %d elements, want 2", len(r.uiqueue))
+	}
+	rect, text := r.uiqueue[0], r.uiqueue[1]
+	if rect.Type != "rect" || text.Type != "text" {
+		t.Fatalf("queue order = %s, %s; want rect, text", rect.Type, text.Type)
+	}
+	if rect.Position != (mgl32.Vec3{5, 5, 0}) || rect.Size != (mgl32.Vec3{200, 40, 0}) || rect.Color != panel {
+		t.Errorf("rect = %+v", rect)
+	}
+}
+
+func TestAlignOffset(t *testing.T) {
+	tests := []struct {
+		align TextAlign
+		want  int32
+	}{
+		{AlignLeft, 0},
+		{AlignCenter, -60},
+		{AlignRight, -120},
+	}
+	for _, tt := range tests {
+		if got := alignOffset(120, tt.align); got != tt.want {
+			t.Errorf("alignOffset(120, %d) = %d, want %d", tt.align, got, tt.want)
+		}
+	}
+
+	// centered text starting at x-60 ends at x+60, i.e. centered on x
+	const x = 400
+	if start := x + alignOffset(120, AlignCenter); start != 340 || start+120-x != x-start {
+		t.Errorf("centered text spans %d..%d around %d", start, start+120, x)
+	}
+}
+
+func TestPushUILine(t *testing.T) {
+	r := &Renderer{}
+	green := mgl32.Vec4{0, 1, 0, 1}
+	r.PushUIText(mgl32.Vec3{10, 10, 0}, green, "label")
+	r.PushUILine(mgl32.Vec3{390, 300, 0}, mgl32.Vec3{410, 300, 0}, green, 2)
+	r.PushUILine(mgl32.Vec3{400, 290, 0}, mgl32.Vec3{400, 310, 0}, green, 2)
+
+	if got := r.GetUICount(); got != 3 {
+		t.Fatalf("GetUICount = %d, want 3", got)
+	}
+	wantTypes := []string{"text", "line", "line"}
+	for i, el := range r.uiqueue {
+		if el.Type != wantTypes[i] {
+			t.Errorf("uiqueue[%d].Type = %s, want %s", i, el.Type, wantTypes[i])
+		}
+	}
+	line := r.uiqueue[1]
+	if line.Position != (mgl32.Vec3{390, 300, 0}) || line.End != (mgl32.Vec3{410, 300, 0}) {
+		t.Errorf("line runs %v -> %v, want (390,300) -> (410,300)", line.Position, line.End)
+	}
+	if line.Thickness != 2 || line.Color != green {
+		t.Errorf("line thickness %v color %v, want 2 %v", line.Thickness, line.Color, green)
+	}
+}
+
+func TestBehindCamera(t *testing.T) {
+	cam := rl.Camera{
+		Position: rl.NewVector3(0, 2, 0),
+		Target:   rl.NewVector3(0, 2, -1),
+		Up:       rl.NewVector3(0, 1, 0),
+		Fovy:     45,
+	}
+	tests := []struct {
+		name string
+		pos  mgl32.Vec3
+		want bool
+	}{
+		{"ahead", mgl32.Vec3{0, 2, -10}, false},
+		{"ahead and off to the side", mgl32.Vec3{50, 0, -1}, false},
+		{"behind", mgl32.Vec3{0, 2, 10}, true},
+		{"level with the camera plane", mgl32.Vec3{5, 2, 0}, true},
+		{"at the camera", mgl32.Vec3{0, 2, 0}, true},
+	}
+	for _, tt := range tests {
+		if got := behindCamera(cam, tt.pos); got != tt.want {
+			t.Errorf("%s: behindCamera(%v) = %v, want %v", tt.name, tt.pos, got, tt.want)
+		}
+	}
+}
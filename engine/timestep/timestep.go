@@ -0,0 +1,67 @@
+package timestep
+
+// DefaultStep is the update interval used when NewFixedStepper gets a
+// non-positive step (60 updates per second).
+const DefaultStep = 1.0 / 60.0
+
+// FixedStepper turns variable frame times into a fixed-rate update callback,
+// so simulation runs the same regardless of framerate.
+type FixedStepper struct {
+	// Step is the fixed update interval in seconds (e.g. 1/60). Accumulate
+	// does nothing while it is <= 0.
+	Step float32
+	// MaxSteps caps updates per Accumulate call so a long stall doesn't
+	// snowball into ever longer frames. Leftover time is dropped.
+	MaxSteps int
+
+	onStep      func(step float32)
+	accumulator float32
+}
+
+// NewFixedStepper creates a stepper calling onStep once per step seconds of
+// accumulated time. A step <= 0 is replaced by DefaultStep, and a nil onStep
+// is a no-op.
+func NewFixedStepper(step float32, onStep func(step float32)) *FixedStepper {
+	if step <= 0 {
+		step = DefaultStep
+	}
+	return &FixedStepper{
+		Step:     step,
+		MaxSteps: 8,
+		onStep:   onStep,
+	}
+}
+
+// Accumulate adds dt seconds of frame time and fires the callback for each
+// whole step available. It returns how many steps ran.
+func (s *FixedStepper) Accumulate(dt float32) int {
+	if s.Step <= 0 {
+		return 0
+	}
+	if dt > 0 {
+		s.accumulator += dt
+	}
+
+	steps := 0
+	for s.accumulator >= s.Step {
+		if s.MaxSteps > 0 && steps >= s.MaxSteps {
+			s.accumulator = 0
+			break
+		}
+		if s.onStep != nil {
+			s.onStep(s.Step)
+		}
+		s.accumulator -= s.Step
+		steps++
+	}
+	return steps
+}
+
+// Alpha returns how far (0..1) the leftover time is into the next step, for
+// interpolating rendered state between the last two updates.
+func (s *FixedStepper) Alpha() float32 {
+	if s.Step <= 0 {
+		return 0
+	}
+	return s.accumulator / s.Step
+}
@@ -0,0 +1,69 @@
+package timestep
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAccumulateIrregularFrames(t *testing.T) {
+	calls := 0
+	s := NewFixedStepper(0.01, func(step float32) {
+		if step != 0.01 {
+			t.Errorf("onStep got step %v, want 0.01", step)
+		}
+		calls++
+	})
+
+	frames := []struct {
+		dt        float32
+		wantSteps int
+		wantAlpha float32
+	}{
+		{0.01, 1, 0},
+		{0.02, 2, 0},
+		{0.005, 0, 0.5},
+		{0.05, 5, 0.5},
+	}
+	total := 0
+	for i, f := range frames {
+		n := s.Accumulate(f.dt)
+		total += n
+		if n != f.wantSteps {
+			t.Errorf("frame %d (dt %v): %d steps, want %d", i, f.dt, n, f.wantSteps)
+		}
+		if a := s.Alpha(); math.Abs(float64(a-f.wantAlpha)) > 1e-3 {
+			t.Errorf("frame %d (dt %v): Alpha = %v, want %v", i, f.dt, a, f.wantAlpha)
+		}
+	}
+	if calls != total || total != 8 {
+		t.Errorf("onStep called %d times for %d steps, want 8", calls, total)
+	}
+}
+
+func TestAccumulateMaxSteps(t *testing.T) {
+	s := NewFixedStepper(0.01, nil)
+	s.MaxSteps = 3
+	if n := s.Accumulate(1); n != 3 {
+		t.Errorf("Accumulate(1) = %d steps, want 3", n)
+	}
+	if a := s.Alpha(); a != 0 {
+		t.Errorf("Alpha after a capped frame = %v, want 0", a)
+	}
+}
+
+func TestNonPositiveStep(t *testing.T) {
+	s := NewFixedStepper(0, nil)
+	if s.Step != DefaultStep {
+		t.Errorf("Step = %v, want DefaultStep", s.Step)
+	}
+
+	// a zero Step set after construction must not spin or divide by zero
+	s.Step = 0
+	s.MaxSteps = 0
+	if n := s.Accumulate(0.1); n != 0 {
+		t.Errorf("Accumulate with Step 0 = %d steps, want 0", n)
+	}
+	if a := s.Alpha(); a != 0 || math.IsNaN(float64(a)) {
+		t.Errorf("Alpha with Step 0 = %v, want 0", a)
+	}
+}
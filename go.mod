@@ -3,6 +3,7 @@ module github.com/bloxown/bo3-client
 go 1.22.2
 
 require (
+	github.com/gen2brain/raylib-go/raylib v0.55.1
 	github.com/go-gl/gl v0.0.0-20231021071112-07e5d0ea2e71
 	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20250301202403-da16c1255728
 	github.com/go-gl/mathgl v1.2.0
@@ -10,7 +11,6 @@ require (
 
 require (
 	github.com/ebitengine/purego v0.7.1 // indirect
-	github.com/gen2brain/raylib-go/raylib v0.55.1 // indirect
 	golang.org/x/exp v0.0.0-20240506185415-9bf2ced13842 // indirect
 	golang.org/x/sys v0.20.0 // indirect
 )
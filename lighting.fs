@@ -29,7 +29,9 @@ struct Light {
     float intensity;
 };
 
-uniform Light lights[8]; // Maximum 8 lights
+#define MAX_LIGHTS 32
+
+uniform Light lights[MAX_LIGHTS]; // Must match maxShaderLights in lights.go
 
 // Output fragment color
 out vec4 finalColor;
@@ -95,7 +97,7 @@ void main()
     }
     
     // Point lights
-    for(int i = 0; i < lightCount && i < 8; i++) {
+    for(int i = 0; i < lightCount && i < MAX_LIGHTS; i++) {
         vec3 lightPos = lights[i].position;
         vec3 lightColor = lights[i].color;
         float lightIntensity = lights[i].intensity;
@@ -0,0 +1,16 @@
+#version 330
+
+// Input vertex attributes (from vertex shader)
+in vec3 fragPosition;
+
+// Input uniform values
+uniform samplerCube environmentMap;
+
+// Output fragment color
+out vec4 finalColor;
+
+void main()
+{
+    vec3 color = texture(environmentMap, fragPosition).rgb;
+    finalColor = vec4(color, 1.0);
+}
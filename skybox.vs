@@ -0,0 +1,21 @@
+#version 330
+
+// Input vertex attributes
+in vec3 vertexPosition;
+
+// Input uniform values
+uniform mat4 matProjection;
+uniform mat4 matView;
+
+// Output vertex attributes (to fragment shader)
+out vec3 fragPosition;
+
+void main()
+{
+    // The cube's local position doubles as the cubemap lookup direction
+    fragPosition = vertexPosition;
+
+    // Drop the view translation so the sky stays centered on the camera
+    mat4 rotView = mat4(mat3(matView));
+    gl_Position = matProjection * rotView * vec4(vertexPosition, 1.0);
+}